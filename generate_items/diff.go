@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+// Writes a human-readable description of the differences between the
+// previously generated gems and the new ones.
+func writeGemDiff(sb *strings.Builder, oldGems []*proto.Gem, newGems []*proto.Gem) {
+	oldByID := make(map[int32]*proto.Gem, len(oldGems))
+	for _, gem := range oldGems {
+		oldByID[gem.Id] = gem
+	}
+	newByID := make(map[int32]*proto.Gem, len(newGems))
+	for _, gem := range newGems {
+		newByID[gem.Id] = gem
+	}
+
+	var added, removed, changed []string
+	for _, id := range sortedGemIDs(newByID) {
+		newGem := newByID[id]
+		oldGem, ok := oldByID[id]
+		if !ok {
+			added = append(added, fmt.Sprintf("%d %s", id, newGem.Name))
+			continue
+		}
+		if changes := gemChanges(oldGem, newGem); len(changes) > 0 {
+			changed = append(changed, fmt.Sprintf("%d %s\n\t\t%s", id, newGem.Name, strings.Join(changes, "\n\t\t")))
+		}
+	}
+	for _, id := range sortedGemIDs(oldByID) {
+		if _, ok := newByID[id]; !ok {
+			removed = append(removed, fmt.Sprintf("%d %s", id, oldByID[id].Name))
+		}
+	}
+
+	writeDiffSection(sb, "Gems", added, removed, changed)
+}
+
+// Writes a human-readable description of the differences between the
+// previously generated items and the new ones.
+func writeItemDiff(sb *strings.Builder, oldItems []*proto.Item, newItems []*proto.Item) {
+	oldByID := make(map[int32]*proto.Item, len(oldItems))
+	for _, item := range oldItems {
+		// Negative IDs are hardcoded in the items package, not generated.
+		if item.Id > 0 {
+			oldByID[item.Id] = item
+		}
+	}
+	newByID := make(map[int32]*proto.Item, len(newItems))
+	for _, item := range newItems {
+		newByID[item.Id] = item
+	}
+
+	var added, removed, changed []string
+	for _, id := range sortedItemIDs(newByID) {
+		newItem := newByID[id]
+		oldItem, ok := oldByID[id]
+		if !ok {
+			added = append(added, fmt.Sprintf("%d %s", id, newItem.Name))
+			continue
+		}
+		if changes := itemChanges(oldItem, newItem); len(changes) > 0 {
+			changed = append(changed, fmt.Sprintf("%d %s\n\t\t%s", id, newItem.Name, strings.Join(changes, "\n\t\t")))
+		}
+	}
+	for _, id := range sortedItemIDs(oldByID) {
+		if _, ok := newByID[id]; !ok {
+			removed = append(removed, fmt.Sprintf("%d %s", id, oldByID[id].Name))
+		}
+	}
+
+	writeDiffSection(sb, "Items", added, removed, changed)
+}
+
+func writeDiffSection(sb *strings.Builder, title string, added []string, removed []string, changed []string) {
+	sb.WriteString(fmt.Sprintf("%s: %d added, %d removed, %d changed\n", title, len(added), len(removed), len(changed)))
+	for _, line := range added {
+		sb.WriteString(fmt.Sprintf("\t+ %s\n", line))
+	}
+	for _, line := range removed {
+		sb.WriteString(fmt.Sprintf("\t- %s\n", line))
+	}
+	for _, line := range changed {
+		sb.WriteString(fmt.Sprintf("\t~ %s\n", line))
+	}
+}
+
+func gemChanges(oldGem *proto.Gem, newGem *proto.Gem) []string {
+	var changes []string
+	changes = appendChange(changes, "Name", oldGem.Name, newGem.Name)
+	changes = appendChange(changes, "Color", oldGem.Color.String(), newGem.Color.String())
+	changes = appendChange(changes, "Phase", oldGem.Phase, newGem.Phase)
+	changes = appendChange(changes, "Quality", oldGem.Quality.String(), newGem.Quality.String())
+	changes = appendChange(changes, "Unique", oldGem.Unique, newGem.Unique)
+	changes = append(changes, statChanges("Stats", oldGem.Stats, newGem.Stats)...)
+	return changes
+}
+
+func itemChanges(oldItem *proto.Item, newItem *proto.Item) []string {
+	var changes []string
+	changes = appendChange(changes, "Name", oldItem.Name, newItem.Name)
+	changes = appendChange(changes, "Type", oldItem.Type.String(), newItem.Type.String())
+	changes = appendChange(changes, "ArmorType", oldItem.ArmorType.String(), newItem.ArmorType.String())
+	changes = appendChange(changes, "WeaponType", oldItem.WeaponType.String(), newItem.WeaponType.String())
+	changes = appendChange(changes, "HandType", oldItem.HandType.String(), newItem.HandType.String())
+	changes = appendChange(changes, "RangedWeaponType", oldItem.RangedWeaponType.String(), newItem.RangedWeaponType.String())
+	changes = appendChange(changes, "WeaponDamageMin", fmt.Sprintf("%0.1f", oldItem.WeaponDamageMin), fmt.Sprintf("%0.1f", newItem.WeaponDamageMin))
+	changes = appendChange(changes, "WeaponDamageMax", fmt.Sprintf("%0.1f", oldItem.WeaponDamageMax), fmt.Sprintf("%0.1f", newItem.WeaponDamageMax))
+	changes = appendChange(changes, "WeaponSpeed", fmt.Sprintf("%0.2f", oldItem.WeaponSpeed), fmt.Sprintf("%0.2f", newItem.WeaponSpeed))
+	changes = appendChange(changes, "ClassAllowlist", fmt.Sprintf("%v", oldItem.ClassAllowlist), fmt.Sprintf("%v", newItem.ClassAllowlist))
+	changes = appendChange(changes, "Phase", oldItem.Phase, newItem.Phase)
+	changes = appendChange(changes, "Quality", oldItem.Quality.String(), newItem.Quality.String())
+	changes = appendChange(changes, "Unique", oldItem.Unique, newItem.Unique)
+	changes = appendChange(changes, "Ilvl", oldItem.Ilvl, newItem.Ilvl)
+	changes = appendChange(changes, "SetName", oldItem.SetName, newItem.SetName)
+	changes = appendChange(changes, "HasSpecialEffect", oldItem.HasSpecialEffect, newItem.HasSpecialEffect)
+	changes = appendChange(changes, "GemSockets", fmt.Sprintf("%v", oldItem.GemSockets), fmt.Sprintf("%v", newItem.GemSockets))
+	changes = append(changes, statChanges("Stats", oldItem.Stats, newItem.Stats)...)
+	changes = append(changes, statChanges("SocketBonus", oldItem.SocketBonus, newItem.SocketBonus)...)
+	return changes
+}
+
+func appendChange(changes []string, field string, oldVal interface{}, newVal interface{}) []string {
+	if oldVal == newVal {
+		return changes
+	}
+	return append(changes, fmt.Sprintf("%s: %v -> %v", field, oldVal, newVal))
+}
+
+func statChanges(field string, oldStats []float64, newStats []float64) []string {
+	var changes []string
+	for i := 0; i < int(stats.Len); i++ {
+		if math.Abs(statValue(oldStats, i)-statValue(newStats, i)) > 0.001 {
+			changes = append(changes, fmt.Sprintf("%s.%s: %0.0f -> %0.0f", field, stats.Stat(i).StatName(), statValue(oldStats, i), statValue(newStats, i)))
+		}
+	}
+	return changes
+}
+
+// Stat lists in the proto may be shorter than stats.Len, missing stats are 0.
+func statValue(statlist []float64, i int) float64 {
+	if i < len(statlist) {
+		return statlist[i]
+	}
+	return 0
+}
+
+func sortedGemIDs(gems map[int32]*proto.Gem) []int32 {
+	ids := make([]int32, 0, len(gems))
+	for id := range gems {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func sortedItemIDs(itemsByID map[int32]*proto.Item) []int32 {
+	ids := make([]int32, 0, len(itemsByID))
+	for id := range itemsByID {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -1,55 +1,151 @@
 package main
 
 import (
-	"bufio"
 	"encoding/csv"
 	"flag"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	googleProto "google.golang.org/protobuf/proto"
 )
 
 func main() {
 	outDir := flag.String("outDir", "", "Path to output directory for writing generated .go files.")
+	refresh := flag.Bool("refresh", false, "Instead of generating files, fetch any tooltips missing from the local tooltip cache and save them.")
+	refreshAll := flag.Bool("refreshAll", false, "Like -refresh, but re-fetches all tooltips, including ones already in the cache.")
+	reportFile := flag.String("reportFile", "", "If set, the validation / diff report is written to this file instead of stdout.")
+	oldDatabase := flag.String("oldDatabase", "", "Item database file (see sim/item_database) with the previously generated data, used for the diff report. Required unless -skipDiff is set.")
+	skipDiff := flag.Bool("skipDiff", false, "Skip the diff report, e.g. because the items package doesn't compile so there's no previous item database.")
 	flag.Parse()
 
+	refreshing := *refresh || *refreshAll
+	tooltipCache := LoadTooltipCache(tooltipCachePath, !refreshing)
+	gemDeclarations := getGemDeclarations()
+	itemDeclarations := getItemDeclarations()
+
+	if refreshing {
+		var ids []int
+		for _, gemDeclaration := range gemDeclarations {
+			ids = append(ids, gemDeclaration.ID)
+		}
+		for _, itemDeclaration := range itemDeclarations {
+			ids = append(ids, itemDeclaration.ID)
+		}
+		numFetched := tooltipCache.Refresh(ids, *refreshAll)
+		tooltipCache.Save()
+		log.Printf("Fetched %d tooltips, saved to %s", numFetched, tooltipCachePath)
+		return
+	}
+
 	if *outDir == "" {
 		panic("outDir flag is required!")
 	}
+	if *oldDatabase == "" && !*skipDiff {
+		log.Fatalf("oldDatabase flag is required for the diff report, or set -skipDiff to generate without one.")
+	}
 
-	tooltipsDB := getWowheadTooltipsDB()
+	var missingIDs []int
 
-	gemDeclarations := getGemDeclarations()
-	gemsData := make([]GemData, len(gemDeclarations))
-	for idx, gemDeclaration := range gemDeclarations {
-		gemData := GemData{
-			Declaration: gemDeclaration,
-			Response:    getWowheadItemResponse(gemDeclaration.ID, tooltipsDB),
+	gemsData := make([]GemData, 0, len(gemDeclarations))
+	for _, gemDeclaration := range gemDeclarations {
+		response, ok := getWowheadItemResponse(gemDeclaration.ID, tooltipCache)
+		if !ok {
+			missingIDs = append(missingIDs, gemDeclaration.ID)
+			continue
 		}
-		//log.Printf("\n\n%+v\n", gemData.Response)
-		gemsData[idx] = gemData
+		gemsData = append(gemsData, GemData{
+			Declaration: gemDeclaration,
+			Response:    response,
+		})
 	}
 	sort.SliceStable(gemsData, func(i, j int) bool {
 		return gemsData[i].Response.Name < gemsData[j].Response.Name
 	})
-	writeGemFile(*outDir, gemsData)
+	gemsData = filterGems(gemsData)
+	newGems := make([]*proto.Gem, len(gemsData))
+	for i, gemData := range gemsData {
+		newGems[i] = gemFromData(gemData)
+	}
 
-	itemDeclarations := getItemDeclarations()
-	itemsData := make([]ItemData, len(itemDeclarations))
-	for idx, itemDeclaration := range itemDeclarations {
-		itemData := ItemData{
-			Declaration: itemDeclaration,
-			Response:    getWowheadItemResponse(itemDeclaration.ID, tooltipsDB),
+	itemsData := make([]ItemData, 0, len(itemDeclarations))
+	for _, itemDeclaration := range itemDeclarations {
+		response, ok := getWowheadItemResponse(itemDeclaration.ID, tooltipCache)
+		if !ok {
+			missingIDs = append(missingIDs, itemDeclaration.ID)
+			continue
 		}
-		//fmt.Printf("\n\n%+v\n", itemData.Response)
-		itemsData[idx] = itemData
+		itemsData = append(itemsData, ItemData{
+			Declaration: itemDeclaration,
+			Response:    response,
+		})
 	}
 	sort.SliceStable(itemsData, func(i, j int) bool {
 		return itemsData[i].Response.Name < itemsData[j].Response.Name
 	})
-	writeItemFile(*outDir, itemsData)
+	itemsData = filterItems(itemsData)
+	newItems := make([]*proto.Item, len(itemsData))
+	for i, itemData := range itemsData {
+		newItems[i] = itemFromData(itemData)
+	}
+
+	if len(missingIDs) > 0 {
+		// Generating without these would silently drop them from the output files.
+		log.Fatalf("%d IDs are missing from the tooltip cache, run with -refresh to fetch them: %v", len(missingIDs), missingIDs)
+	}
+
+	report := &strings.Builder{}
+	issues := validateItems(itemsData)
+	report.WriteString(fmt.Sprintf("Unparsed item effects: %d\n", len(issues)))
+	for _, issue := range issues {
+		report.WriteString(fmt.Sprintf("\t%s\n", issue))
+	}
+	report.WriteString("\n")
+	if *skipDiff {
+		report.WriteString("Skipping diff with the previous item database.\n")
+	} else {
+		oldDB := loadOldDatabase(*oldDatabase)
+		writeGemDiff(report, oldDB.Gems, newGems)
+		report.WriteString("\n")
+		writeItemDiff(report, oldDB.Items, newItems)
+	}
+
+	writeGemFile(*outDir, newGems)
+	writeItemFile(*outDir, newItems)
+
+	if *reportFile != "" {
+		if err := ioutil.WriteFile(*reportFile, []byte(report.String()), 0644); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Wrote report to %s", *reportFile)
+	} else {
+		fmt.Print(report.String())
+	}
+}
+
+// Reads the previously generated data for the diff report.
+func loadOldDatabase(path string) *proto.ItemDatabase {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Failed to read previous item database %s, set -skipDiff to generate without one: %s", path, err)
+	}
+
+	db := &proto.ItemDatabase{}
+	if strings.HasSuffix(path, ".json") {
+		err = protojson.Unmarshal(data, db)
+	} else {
+		err = googleProto.Unmarshal(data, db)
+	}
+	if err != nil {
+		log.Fatalf("Failed to parse previous item database %s: %s", path, err)
+	}
+	return db
 }
 
 func getGemDeclarations() []GemDeclaration {
@@ -139,40 +235,6 @@ func getItemDeclarations() []ItemDeclaration {
 	return itemDeclarations
 }
 
-// Returns the prefetched list of all wowhead tooltips.
-// Maps item IDs to tooltip strings.
-func getWowheadTooltipsDB() map[int]string {
-	file, err := os.Open("./assets/item_data/all_item_tooltips.csv")
-	if err != nil {
-		log.Fatal(err)
-	}
-	defer file.Close()
-
-	db := make(map[int]string)
-	scanner := bufio.NewScanner(file)
-	i := 0
-	for scanner.Scan() {
-		i++
-		if i == 1 {
-			// Ignore first line
-			continue
-		}
-
-		line := scanner.Text()
-
-		itemIDStr := line[:strings.Index(line, ",")]
-		itemID, err := strconv.Atoi(itemIDStr)
-		if err != nil {
-			log.Fatal("Invalid item ID: " + itemIDStr)
-		}
-
-		tooltip := line[strings.Index(line, "{"):]
-		db[itemID] = tooltip
-	}
-
-	return db
-}
-
 func readCsvFile(filePath string) [][]string {
 	f, err := os.Open(filePath)
 	if err != nil {
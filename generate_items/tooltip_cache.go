@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const tooltipCachePath = "./assets/item_data/all_item_tooltips.csv"
+
+// Local store of wowhead tooltips, keyed by item ID. Generation only ever reads
+// from this, so results are reproducible and don't need network access. The
+// cache is only updated when running in refresh mode.
+type TooltipCache struct {
+	path     string
+	tooltips map[int]string
+}
+
+// Loads the cache from disk. A missing file is only allowed when mustExist is
+// false, i.e. when the cache is about to be filled by Refresh().
+func LoadTooltipCache(path string, mustExist bool) *TooltipCache {
+	cache := &TooltipCache{
+		path:     path,
+		tooltips: make(map[int]string),
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) && !mustExist {
+			log.Printf("Tooltip cache %s does not exist, starting with an empty cache.", path)
+			return cache
+		}
+		log.Fatalf("Failed to open tooltip cache %s, run with -refresh to create it: %s", path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	// Some tooltips are longer than the default buffer size.
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	i := 0
+	for scanner.Scan() {
+		i++
+		if i == 1 {
+			// Ignore first line
+			continue
+		}
+
+		line := scanner.Text()
+
+		itemIDStr := line[:strings.Index(line, ",")]
+		itemID, err := strconv.Atoi(itemIDStr)
+		if err != nil {
+			log.Fatal("Invalid item ID: " + itemIDStr)
+		}
+
+		tooltip := line[strings.Index(line, "{"):]
+		cache.tooltips[itemID] = tooltip
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatalf("Failed to read tooltip cache %s: %s", path, err)
+	}
+
+	return cache
+}
+
+func (cache *TooltipCache) Get(itemID int) (string, bool) {
+	tooltip, ok := cache.tooltips[itemID]
+	return tooltip, ok
+}
+
+// Fetches tooltips from wowhead for the given IDs. If refreshAll is false, only
+// IDs which are missing from the cache are fetched. Returns the number of
+// tooltips which were fetched successfully.
+func (cache *TooltipCache) Refresh(itemIDs []int, refreshAll bool) int {
+	numFetched := 0
+	for _, itemID := range itemIDs {
+		if _, ok := cache.tooltips[itemID]; ok && !refreshAll {
+			continue
+		}
+
+		tooltip, err := fetchWowheadTooltip(itemID)
+		if err != nil {
+			log.Printf("Failed to fetch tooltip for item %d: %s", itemID, err)
+			continue
+		}
+		cache.tooltips[itemID] = tooltip
+		numFetched++
+	}
+	return numFetched
+}
+
+// Writes the cache back to disk, sorted by ID so the file diffs cleanly.
+func (cache *TooltipCache) Save() {
+	ids := make([]int, 0, len(cache.tooltips))
+	for id := range cache.tooltips {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	file, err := os.Create(cache.path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	writer.WriteString("id,tooltip\n")
+	for _, id := range ids {
+		writer.WriteString(fmt.Sprintf("%d,%s\n", id, cache.tooltips[id]))
+	}
+	if err := writer.Flush(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func fetchWowheadTooltip(itemID int) (string, error) {
+	url := fmt.Sprintf("https://tbc.wowhead.com/tooltip/item/%d", itemID)
+
+	httpClient := http.Client{
+		Timeout: 5 * time.Second,
+	}
+
+	result, err := httpClient.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer result.Body.Close()
+
+	if result.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s", result.Status)
+	}
+
+	resultBody, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return "", err
+	}
+
+	// The cache format is one tooltip per line.
+	return strings.ReplaceAll(string(resultBody), "\n", ""), nil
+}
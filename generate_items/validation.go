@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Matches the special effect lines of a tooltip, e.g. "Equip: ...", "Use: ...".
+var tooltipEffectRegex = regexp.MustCompile("<span class=\\\"q2\\\">((Equip|Use|Chance on hit): .*?)</span>")
+var htmlTagRegex = regexp.MustCompile("<[^>]*>")
+
+// Equip lines which are fully handled by GetStats().
+var parsedEquipRegexes = []*regexp.Regexp{
+	spellPowerRegex,
+	healingPowerRegex,
+	arcaneSpellPowerRegex,
+	fireSpellPowerRegex,
+	frostSpellPowerRegex,
+	holySpellPowerRegex,
+	natureSpellPowerRegex,
+	shadowSpellPowerRegex,
+	spellHitRegex,
+	spellHitRegex2,
+	spellCritRegex,
+	spellCritRegex2,
+	spellHasteRegex,
+	spellPenetrationRegex,
+	mp5Regex,
+	attackPowerRegex,
+	meleeHitRegex,
+	meleeHitRegex2,
+	meleeCritRegex,
+	meleeCritRegex2,
+	meleeHasteRegex,
+	armorPenetrationRegex,
+	expertiseRegex,
+}
+
+// Equip lines which aren't parsed, but don't matter for the sim so shouldn't be
+// reported.
+var ignoredEquipRegexes = []*regexp.Regexp{
+	regexp.MustCompile("Increases defense rating by"),
+	regexp.MustCompile("Increases your dodge rating by"),
+	regexp.MustCompile("Increases your parry rating by"),
+	regexp.MustCompile("Increases your shield block rating by"),
+	regexp.MustCompile("Increases the block value of your shield by"),
+	regexp.MustCompile("Improves your resilience rating by"),
+	regexp.MustCompile("Increases attack power by [0-9]+ in Cat, Bear, Dire Bear, and Moonkin forms only\\."),
+	regexp.MustCompile("Increases your effective stealth level"),
+	regexp.MustCompile("Increases swim speed"),
+	regexp.MustCompile("Run speed increased"),
+	regexp.MustCompile("Increases mount speed"),
+	regexp.MustCompile("Restores [0-9]+ health per 5 sec\\."),
+}
+
+type ValidationIssue struct {
+	ItemID   int
+	ItemName string
+	Line     string
+}
+
+func (issue ValidationIssue) String() string {
+	return fmt.Sprintf("%d %s: %s", issue.ItemID, issue.ItemName, issue.Line)
+}
+
+// Returns the tooltip effect lines which are not parsed into stats, with html
+// tags removed.
+func (item WowheadItemResponse) GetUnparsedEffectLines() []string {
+	var lines []string
+	for _, match := range tooltipEffectRegex.FindAllStringSubmatch(item.TooltipWithoutSet(), -1) {
+		line := match[1]
+		if match[2] == "Equip" && (matchesAny(line, parsedEquipRegexes) || matchesAny(line, ignoredEquipRegexes)) {
+			continue
+		}
+		lines = append(lines, strings.TrimSpace(htmlTagRegex.ReplaceAllString(line, "")))
+	}
+	return lines
+}
+
+// Finds tooltip effect lines which are not parsed into stats. Items with such
+// lines are marked with HasSpecialEffect; whether the sim implements them is
+// checked by `make effect_coverage`, since the generator can't depend on the
+// sim packages it generates.
+func validateItems(itemsData []ItemData) []ValidationIssue {
+	var issues []ValidationIssue
+	for _, itemData := range itemsData {
+		for _, line := range itemData.Response.GetUnparsedEffectLines() {
+			issues = append(issues, ValidationIssue{
+				ItemID:   itemData.Declaration.ID,
+				ItemName: itemData.Response.Name,
+				Line:     line,
+			})
+		}
+	}
+	return issues
+}
+
+func matchesAny(str string, patterns []*regexp.Regexp) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(str) {
+			return true
+		}
+	}
+	return false
+}
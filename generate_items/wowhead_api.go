@@ -3,14 +3,11 @@ package main
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"math"
-	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/wowsims/tbc/sim/core/proto"
 )
@@ -220,6 +217,16 @@ func (item WowheadItemResponse) IsEquippable() bool {
 	return true
 }
 
+var setNameRegex = regexp.MustCompile("<a href=\\\"/item-set=-?[0-9]+\\\"[^>]*>([^<]+)</a>")
+
+func (item WowheadItemResponse) GetSetName() string {
+	match := setNameRegex.FindStringSubmatch(item.Tooltip)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
 var itemLevelRegex = regexp.MustCompile("Item Level <!--ilvl-->([0-9]+)<")
 
 func (item WowheadItemResponse) GetItemLevel() int {
@@ -521,45 +528,19 @@ func (item WowheadItemResponse) GetGemStats() Stats {
 	return stats
 }
 
-func getWowheadItemResponse(itemID int, tooltipsDB map[int]string) WowheadItemResponse {
-	// If the db already has it, just return the db value.
-	var tooltipBytes []byte
-
-	if tooltipStr, ok := tooltipsDB[itemID]; ok {
-		tooltipBytes = []byte(tooltipStr)
-	} else {
-		fmt.Printf("Item DB missing ID: %d\n", itemID)
-		url := fmt.Sprintf("https://tbc.wowhead.com/tooltip/item/%d", itemID)
-
-		httpClient := http.Client{
-			Timeout: 5 * time.Second,
-		}
-
-		request, err := http.NewRequest(http.MethodGet, url, nil)
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		result, err := httpClient.Do(request)
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		defer result.Body.Close()
-
-		resultBody, err := ioutil.ReadAll(result.Body)
-		if err != nil {
-			log.Fatal(err)
-		}
-		tooltipBytes = resultBody
+// Looks up the wowhead response for an item in the tooltip cache. Returns false
+// if the cache doesn't have the item; run the generator with -refresh to fetch it.
+func getWowheadItemResponse(itemID int, tooltipCache *TooltipCache) (WowheadItemResponse, bool) {
+	tooltipStr, ok := tooltipCache.Get(itemID)
+	if !ok {
+		return WowheadItemResponse{}, false
 	}
 
-	//fmt.Printf(string(tooltipStr))
 	itemResponse := WowheadItemResponse{}
-	err := json.Unmarshal(tooltipBytes, &itemResponse)
+	err := json.Unmarshal([]byte(tooltipStr), &itemResponse)
 	if err != nil {
-		log.Fatal(err)
+		log.Fatalf("Failed to parse cached tooltip for item %d: %s", itemID, err)
 	}
 
-	return itemResponse
+	return itemResponse, true
 }
@@ -19,30 +19,9 @@ func specInSlice(a proto.Spec, list []proto.Spec) bool {
 	return false
 }
 
-func writeGemFile(outDir string, gemsData []GemData) {
-	err := os.MkdirAll(outDir, os.ModePerm)
-	if err != nil {
-		panic(err)
-	}
-
-	file, err := os.Create(fmt.Sprintf("%s/all_gems.go", outDir))
-	if err != nil {
-		panic(err)
-	}
-	defer file.Close()
-
-	file.WriteString(`// DO NOT EDIT. This file is auto-generated by the item generator tool. Use that to make edits.
-	
-package items
-	
-import (
-	"github.com/wowsims/tbc/sim/core/proto"
-	"github.com/wowsims/tbc/sim/core/stats"
-)
-
-var Gems = []Gem{
-`)
-
+// Returns the gems which should be included in the sim.
+func filterGems(gemsData []GemData) []GemData {
+	var included []GemData
 	for _, gemData := range gemsData {
 		if gemData.Declaration.Filter {
 			continue
@@ -56,38 +35,14 @@ var Gems = []Gem{
 				continue
 			}
 		}
-		file.WriteString(fmt.Sprintf("\t%s,\n", gemToGoString(gemData.Declaration, gemData.Response)))
+		included = append(included, gemData)
 	}
-
-	file.WriteString("}\n")
-
-	file.Sync()
+	return included
 }
 
-func writeItemFile(outDir string, itemsData []ItemData) {
-	err := os.MkdirAll(outDir, os.ModePerm)
-	if err != nil {
-		panic(err)
-	}
-
-	file, err := os.Create(fmt.Sprintf("%s/all_items.go", outDir))
-	if err != nil {
-		panic(err)
-	}
-	defer file.Close()
-
-	file.WriteString(`// DO NOT EDIT. This file is auto-generated by the item generator tool. Use that to make edits.
-	
-package items
-	
-import (
-	"github.com/wowsims/tbc/sim/core/proto"
-	"github.com/wowsims/tbc/sim/core/stats"
-)
-
-var Items = []Item{
-`)
-
+// Returns the items which should be included in the sim.
+func filterItems(itemsData []ItemData) []ItemData {
+	var included []ItemData
 	for _, itemData := range itemsData {
 		itemLevel := itemData.Response.GetItemLevel()
 		if itemData.Declaration.Filter {
@@ -124,8 +79,37 @@ var Items = []Item{
 		if itemLevel == 0 {
 			fmt.Printf("Missing ilvl: %s", itemData.Response.Name)
 		}
+		included = append(included, itemData)
+	}
+	return included
+}
 
-		file.WriteString(fmt.Sprintf("\t%s,\n", itemToGoString(itemData.Declaration, itemData.Response)))
+func writeGemFile(outDir string, gems []*proto.Gem) {
+	err := os.MkdirAll(outDir, os.ModePerm)
+	if err != nil {
+		panic(err)
+	}
+
+	file, err := os.Create(fmt.Sprintf("%s/all_gems.go", outDir))
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+
+	file.WriteString(`// DO NOT EDIT. This file is auto-generated by the item generator tool. Use that to make edits.
+	
+package items
+	
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+var Gems = []Gem{
+`)
+
+	for _, gem := range gems {
+		file.WriteString(fmt.Sprintf("\t%s,\n", gemToGoString(gem)))
 	}
 
 	file.WriteString("}\n")
@@ -133,22 +117,123 @@ var Items = []Item{
 	file.Sync()
 }
 
-func gemToGoString(gemDeclaration GemDeclaration, gemResponse WowheadItemResponse) string {
-	gemStr := "{"
+func writeItemFile(outDir string, itemList []*proto.Item) {
+	err := os.MkdirAll(outDir, os.ModePerm)
+	if err != nil {
+		panic(err)
+	}
+
+	file, err := os.Create(fmt.Sprintf("%s/all_items.go", outDir))
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+
+	file.WriteString(`// DO NOT EDIT. This file is auto-generated by the item generator tool. Use that to make edits.
+	
+package items
+	
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
 
-	gemStr += fmt.Sprintf("Name:\"%s\", ", gemResponse.Name)
-	gemStr += fmt.Sprintf("ID:%d, ", gemDeclaration.ID)
+var Items = []Item{
+`)
+
+	for _, item := range itemList {
+		file.WriteString(fmt.Sprintf("\t%s,\n", itemToGoString(item)))
+	}
+
+	file.WriteString("}\n")
+
+	file.Sync()
+}
+
+// Converts the parsed wowhead data into the proto used by the sim.
+func gemFromData(gemData GemData) *proto.Gem {
+	gemDeclaration := gemData.Declaration
+	gemResponse := gemData.Response
 
 	phase := gemDeclaration.Phase
 	if phase == 0 {
 		phase = gemResponse.GetPhase()
 	}
-	gemStr += fmt.Sprintf("Phase:%d, ", phase)
-	gemStr += fmt.Sprintf("Quality:proto.ItemQuality_%s, ", proto.ItemQuality(gemResponse.Quality).String())
-	gemStr += fmt.Sprintf("Color:proto.GemColor_%s, ", proto.GemColor(gemResponse.GetSocketColor()).String())
-	gemStr += fmt.Sprintf("Stats: %s, ", statsToGoString(gemResponse.GetGemStats(), gemDeclaration.Stats))
 
-	if gemResponse.GetUnique() {
+	return &proto.Gem{
+		Id:      int32(gemDeclaration.ID),
+		Name:    gemResponse.Name,
+		Stats:   applyStatOverrides(gemResponse.GetGemStats(), gemDeclaration.Stats),
+		Color:   gemResponse.GetSocketColor(),
+		Phase:   int32(phase),
+		Quality: proto.ItemQuality(gemResponse.Quality),
+		Unique:  gemResponse.GetUnique(),
+	}
+}
+
+// Converts the parsed wowhead data into the proto used by the sim.
+func itemFromData(itemData ItemData) *proto.Item {
+	itemDeclaration := itemData.Declaration
+	itemResponse := itemData.Response
+
+	item := &proto.Item{
+		Id:             int32(itemDeclaration.ID),
+		Name:           itemResponse.Name,
+		ClassAllowlist: itemResponse.GetClassAllowlist(),
+		Type:           itemResponse.GetItemType(),
+		ArmorType:      itemResponse.GetArmorType(),
+		WeaponType:     itemResponse.GetWeaponType(),
+		Quality:        proto.ItemQuality(itemResponse.Quality),
+		Unique:         itemResponse.GetUnique(),
+		Ilvl:           int32(itemResponse.GetItemLevel()),
+		Stats:          applyStatOverrides(itemResponse.GetStats(), itemDeclaration.Stats),
+		GemSockets:     itemResponse.GetGemSockets(),
+		SocketBonus:    applyStatOverrides(itemResponse.GetSocketBonus(), Stats{}),
+
+		SetName:          itemResponse.GetSetName(),
+		HasSpecialEffect: len(itemResponse.GetUnparsedEffectLines()) > 0,
+	}
+
+	if len(itemDeclaration.ClassAllowlist) > 0 {
+		item.ClassAllowlist = itemDeclaration.ClassAllowlist
+	}
+
+	if item.WeaponType != proto.WeaponType_WeaponTypeUnknown {
+		item.HandType = itemResponse.GetHandType()
+		if item.HandType == proto.HandType_HandTypeUnknown {
+			panic("Unknown hand type for item: " + itemResponse.Tooltip)
+		}
+	} else {
+		item.RangedWeaponType = itemResponse.GetRangedWeaponType()
+	}
+
+	min, max := itemResponse.GetWeaponDamage()
+	if min != 0 && max != 0 {
+		item.WeaponDamageMin = min
+		item.WeaponDamageMax = max
+	}
+	item.WeaponSpeed = itemResponse.GetWeaponSpeed()
+
+	phase := itemDeclaration.Phase
+	if phase == 0 {
+		phase = itemResponse.GetPhase()
+	}
+	item.Phase = int32(phase)
+
+	return item
+}
+
+func gemToGoString(gem *proto.Gem) string {
+	gemStr := "{"
+
+	gemStr += fmt.Sprintf("Name:\"%s\", ", gem.Name)
+	gemStr += fmt.Sprintf("ID:%d, ", gem.Id)
+	gemStr += fmt.Sprintf("Phase:%d, ", gem.Phase)
+	gemStr += fmt.Sprintf("Quality:proto.ItemQuality_%s, ", gem.Quality.String())
+	gemStr += fmt.Sprintf("Color:proto.GemColor_%s, ", gem.Color.String())
+	gemStr += fmt.Sprintf("Stats: %s, ", statsToGoString(gem.Stats))
+
+	if gem.Unique {
 		gemStr += fmt.Sprintf("Unique:true, ")
 	}
 
@@ -156,97 +241,93 @@ func gemToGoString(gemDeclaration GemDeclaration, gemResponse WowheadItemRespons
 	return gemStr
 }
 
-func itemToGoString(itemDeclaration ItemDeclaration, itemResponse WowheadItemResponse) string {
+func itemToGoString(item *proto.Item) string {
 	itemStr := "{"
 
-	itemStr += fmt.Sprintf("Name:\"%s\", ", strings.ReplaceAll(itemResponse.Name, "\"", "\\\""))
-	itemStr += fmt.Sprintf("ID:%d, ", itemDeclaration.ID)
+	itemStr += fmt.Sprintf("Name:\"%s\", ", strings.ReplaceAll(item.Name, "\"", "\\\""))
+	itemStr += fmt.Sprintf("ID:%d, ", item.Id)
 
-	classAllowlist := itemResponse.GetClassAllowlist()
-	if len(itemDeclaration.ClassAllowlist) > 0 {
-		classAllowlist = itemDeclaration.ClassAllowlist
-	}
-	if len(classAllowlist) > 0 {
+	if len(item.ClassAllowlist) > 0 {
 		itemStr += "ClassAllowlist: []proto.Class{"
-		for _, class := range classAllowlist {
+		for _, class := range item.ClassAllowlist {
 			itemStr += fmt.Sprintf("proto.Class_%s,", class.String())
 		}
 		itemStr += "}, "
 	}
 
-	itemStr += fmt.Sprintf("Type:proto.ItemType_%s, ", itemResponse.GetItemType().String())
+	itemStr += fmt.Sprintf("Type:proto.ItemType_%s, ", item.Type.String())
 
-	armorType := itemResponse.GetArmorType()
-	if armorType != proto.ArmorType_ArmorTypeUnknown {
-		itemStr += fmt.Sprintf("ArmorType:proto.ArmorType_%s, ", armorType.String())
+	if item.ArmorType != proto.ArmorType_ArmorTypeUnknown {
+		itemStr += fmt.Sprintf("ArmorType:proto.ArmorType_%s, ", item.ArmorType.String())
 	}
 
-	weaponType := itemResponse.GetWeaponType()
-	if weaponType != proto.WeaponType_WeaponTypeUnknown {
-		itemStr += fmt.Sprintf("WeaponType:proto.WeaponType_%s, ", weaponType.String())
-
-		handType := itemResponse.GetHandType()
-		if handType == proto.HandType_HandTypeUnknown {
-			panic("Unknown hand type for item: " + itemResponse.Tooltip)
-		}
-		itemStr += fmt.Sprintf("HandType:proto.HandType_%s, ", handType.String())
-	} else {
-		rangedWeaponType := itemResponse.GetRangedWeaponType()
-		if rangedWeaponType != proto.RangedWeaponType_RangedWeaponTypeUnknown {
-			itemStr += fmt.Sprintf("RangedWeaponType:proto.RangedWeaponType_%s, ", rangedWeaponType.String())
-		}
+	if item.WeaponType != proto.WeaponType_WeaponTypeUnknown {
+		itemStr += fmt.Sprintf("WeaponType:proto.WeaponType_%s, ", item.WeaponType.String())
+		itemStr += fmt.Sprintf("HandType:proto.HandType_%s, ", item.HandType.String())
+	} else if item.RangedWeaponType != proto.RangedWeaponType_RangedWeaponTypeUnknown {
+		itemStr += fmt.Sprintf("RangedWeaponType:proto.RangedWeaponType_%s, ", item.RangedWeaponType.String())
 	}
 
-	min, max := itemResponse.GetWeaponDamage()
-	if min != 0 && max != 0 {
-		itemStr += fmt.Sprintf("WeaponDamageMin: %0.1f, ", min)
-		itemStr += fmt.Sprintf("WeaponDamageMax: %0.1f, ", max)
+	if item.WeaponDamageMin != 0 && item.WeaponDamageMax != 0 {
+		itemStr += fmt.Sprintf("WeaponDamageMin: %0.1f, ", item.WeaponDamageMin)
+		itemStr += fmt.Sprintf("WeaponDamageMax: %0.1f, ", item.WeaponDamageMax)
 	}
-	speed := itemResponse.GetWeaponSpeed()
-	if speed != 0 {
-		itemStr += fmt.Sprintf("SwingSpeed: %0.2f, ", speed)
+	if item.WeaponSpeed != 0 {
+		itemStr += fmt.Sprintf("SwingSpeed: %0.2f, ", item.WeaponSpeed)
 	}
 
-	phase := itemDeclaration.Phase
-	if phase == 0 {
-		phase = itemResponse.GetPhase()
-	}
-	itemStr += fmt.Sprintf("Phase:%d, ", phase)
-	itemStr += fmt.Sprintf("Quality:proto.ItemQuality_%s, ", proto.ItemQuality(itemResponse.Quality).String())
+	itemStr += fmt.Sprintf("Phase:%d, ", item.Phase)
+	itemStr += fmt.Sprintf("Quality:proto.ItemQuality_%s, ", item.Quality.String())
 
-	if itemResponse.GetUnique() {
+	if item.Unique {
 		itemStr += fmt.Sprintf("Unique:true, ")
 	}
 
-	itemStr += fmt.Sprintf("Ilvl:%d, ", itemResponse.GetItemLevel())
+	itemStr += fmt.Sprintf("Ilvl:%d, ", item.Ilvl)
 
-	itemStr += fmt.Sprintf("Stats: %s, ", statsToGoString(itemResponse.GetStats(), itemDeclaration.Stats))
+	itemStr += fmt.Sprintf("Stats: %s, ", statsToGoString(item.Stats))
 
-	gemSockets := itemResponse.GetGemSockets()
-	if len(gemSockets) > 0 {
+	if len(item.GemSockets) > 0 {
 		itemStr += "GemSockets: []proto.GemColor{"
-		for _, gemColor := range gemSockets {
+		for _, gemColor := range item.GemSockets {
 			itemStr += fmt.Sprintf("proto.GemColor_%s,", gemColor.String())
 		}
 		itemStr += "}, "
 	}
 
-	itemStr += fmt.Sprintf("SocketBonus: %s", statsToGoString(itemResponse.GetSocketBonus(), Stats{}))
+	itemStr += fmt.Sprintf("SocketBonus: %s, ", statsToGoString(item.SocketBonus))
+
+	if item.SetName != "" {
+		itemStr += fmt.Sprintf("SetName:\"%s\", ", strings.ReplaceAll(item.SetName, "\"", "\\\""))
+	}
+	if item.HasSpecialEffect {
+		itemStr += "HasSpecialEffect:true"
+	}
 
 	itemStr += "}"
 	return itemStr
 }
 
-func statsToGoString(statlist Stats, overrides Stats) string {
+// Overrides are only applied to stats which were parsed from the tooltip.
+func applyStatOverrides(statlist Stats, overrides Stats) []float64 {
+	result := make([]float64, stats.Len)
+	for stat, value := range statlist {
+		if value > 0 {
+			result[stat] = value
+			if overrides[stat] > 0 {
+				result[stat] = overrides[stat]
+			}
+		}
+	}
+	return result
+}
+
+func statsToGoString(statlist []float64) string {
 	statsStr := "stats.Stats{"
 
 	for stat, value := range statlist {
-		val := value
-		if overrides[stat] > 0 {
-			val = overrides[stat]
-		}
 		if value > 0 {
-			statsStr += fmt.Sprintf("stats.%s:%.0f,", stats.Stat(stat).StatName(), val)
+			statsStr += fmt.Sprintf("stats.%s:%.0f,", stats.Stat(stat).StatName(), value)
 		}
 	}
 
@@ -372,7 +372,7 @@ func ApplyDespair(agent core.Agent) {
 				}
 
 				abilityTemplate.Apply(&cast)
-				cast.Effect.Target = sim.GetPrimaryTarget()
+				cast.Effect.Target = hitEffect.Target
 				cast.Attack(sim)
 			},
 		}
@@ -427,7 +427,7 @@ func ApplyTheDecapitator(agent core.Agent) {
 		ActivationFactory: func(sim *core.Simulation) core.CooldownActivation {
 			return func(sim *core.Simulation, character *core.Character) {
 				abilityTemplate.Apply(&ability)
-				ability.Effect.Target = sim.GetPrimaryTarget()
+				ability.Effect.Target = character.CurrentTarget(sim)
 				ability.Attack(sim)
 
 				character.SetCD(TheDecapitatorCooldownID, sim.CurrentTime+time.Minute*3)
@@ -1,11 +1,15 @@
 package core
 
 import (
+	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
 
+	"github.com/wowsims/tbc/sim/core/items"
 	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/talents"
+	googleProto "google.golang.org/protobuf/proto"
 )
 
 // Agent can be thought of as the 'Player', i.e. the thing controlling the Character.
@@ -141,7 +145,7 @@ func RegisterAgentFactory(emptyOptions interface{}, factory AgentFactory, specSe
 }
 
 // Constructs a new Agent.
-func NewAgent(party *Party, partyIndex int, player proto.Player) Agent {
+func NewAgent(party *Party, partyIndex int, player proto.Player, itemDB *items.Database) Agent {
 	typeName := reflect.TypeOf(player.GetSpec()).Elem().Name()
 
 	factory, ok := agentFactories[typeName]
@@ -149,10 +153,33 @@ func NewAgent(party *Party, partyIndex int, player proto.Player) Agent {
 		panic("No agent factory for type: " + typeName)
 	}
 
-	character := NewCharacter(party, partyIndex, player)
+	if err := talents.ApplyTalentsStringIfMissing(&player); err != nil {
+		panic(err)
+	}
+
+	character := NewCharacter(party, partyIndex, player, itemDB)
 	return factory(character, player)
 }
 
+// Checks the talent string of each player in the raid, so bad strings are
+// reported as errors rather than panicking in NewAgent.
+func validateTalentsStrings(raidConfig *proto.Raid) error {
+	for _, partyConfig := range raidConfig.Parties {
+		for _, playerConfig := range partyConfig.GetPlayers() {
+			if playerConfig == nil || playerConfig.Class == proto.Class_ClassUnknown {
+				continue
+			}
+
+			// Applying the string modifies the player, so use a copy.
+			player := googleProto.Clone(playerConfig).(*proto.Player)
+			if err := talents.ApplyTalentsStringIfMissing(player); err != nil {
+				return fmt.Errorf("invalid talents for %s: %s", playerConfig.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
 // Applies the spec options to the given player. This is only necessary because
 // the generated proto code does not export oneof interface types.
 // Player is returned so this function can be used in-line with player creation.
@@ -167,3 +194,40 @@ func WithSpec(player *proto.Player, spec interface{}) *proto.Player {
 	specSetter(player, spec)
 	return player
 }
+
+// Returns the spec of the given player, or false if the player has no spec set.
+func PlayerProtoToSpec(player *proto.Player) (proto.Spec, bool) {
+	switch player.GetSpec().(type) {
+	case *proto.Player_BalanceDruid:
+		return proto.Spec_SpecBalanceDruid, true
+	case *proto.Player_ElementalShaman:
+		return proto.Spec_SpecElementalShaman, true
+	case *proto.Player_EnhancementShaman:
+		return proto.Spec_SpecEnhancementShaman, true
+	case *proto.Player_HolyPaladin:
+		return proto.Spec_SpecHolyPaladin, true
+	case *proto.Player_HolyPriest:
+		return proto.Spec_SpecHolyPriest, true
+	case *proto.Player_Hunter:
+		return proto.Spec_SpecHunter, true
+	case *proto.Player_Mage:
+		return proto.Spec_SpecMage, true
+	case *proto.Player_RestorationDruid:
+		return proto.Spec_SpecRestorationDruid, true
+	case *proto.Player_RestorationShaman:
+		return proto.Spec_SpecRestorationShaman, true
+	case *proto.Player_RetributionPaladin:
+		return proto.Spec_SpecRetributionPaladin, true
+	case *proto.Player_Rogue:
+		return proto.Spec_SpecRogue, true
+	case *proto.Player_ShadowPriest:
+		return proto.Spec_SpecShadowPriest, true
+	case *proto.Player_SmitePriest:
+		return proto.Spec_SpecSmitePriest, true
+	case *proto.Player_Warlock:
+		return proto.Spec_SpecWarlock, true
+	case *proto.Player_Warrior:
+		return proto.Spec_SpecWarrior, true
+	}
+	return 0, false
+}
@@ -2,6 +2,9 @@
 package core
 
 import (
+	"fmt"
+
+	"github.com/wowsims/tbc/sim/core/encounters"
 	"github.com/wowsims/tbc/sim/core/items"
 	"github.com/wowsims/tbc/sim/core/proto"
 	"github.com/wowsims/tbc/sim/core/stats"
@@ -25,15 +28,34 @@ func GetGearList(request *proto.GearListRequest) *proto.GearListResult {
 		enchant := items.Enchants[i]
 		result.Enchants = append(result.Enchants, enchant.ToProto())
 	}
+	result.UnimplementedEffects = GetUnimplementedEffects(items.DefaultDatabase)
 
 	return result
 }
 
+/**
+ * Returns the preset boss encounters which can be referenced by name in sim requests.
+ */
+func GetEncounterList(request *proto.EncounterListRequest) *proto.EncounterListResult {
+	return encounters.GetEncounterList(request)
+}
+
 /**
  * Returns character stats taking into account gear / buffs / consumes / etc
  */
 func ComputeStats(csr *proto.ComputeStatsRequest) *proto.ComputeStatsResult {
-	raid := NewRaid(*csr.Raid)
+	itemDB, err := items.DefaultDatabase.WithCustomItems(csr.CustomItems)
+	if err != nil {
+		return &proto.ComputeStatsResult{
+			ErrorResult: fmt.Sprintf("invalid custom items: %s", err),
+		}
+	}
+	if err := validateTalentsStrings(csr.Raid); err != nil {
+		return &proto.ComputeStatsResult{
+			ErrorResult: err.Error(),
+		}
+	}
+	raid := NewRaidWithItems(*csr.Raid, itemDB)
 
 	return &proto.ComputeStatsResult{
 		RaidStats: raid.GetStats(),
@@ -81,3 +103,24 @@ func RunRaidSim(request *proto.RaidSimRequest) *proto.RaidSimResult {
 func RunRaidSimAsync(request *proto.RaidSimRequest, progress chan *proto.ProgressMetrics) {
 	go RunSim(*request, progress)
 }
+
+/**
+ * Searches for the party assignments which give the highest raid DPS.
+ */
+func OptimizeParties(request *proto.PartyOptimizerRequest) *proto.PartyOptimizerResult {
+	return optimizeParties(request)
+}
+
+/**
+ * Searches for the cooldown timings which give the highest raid DPS.
+ */
+func OptimizeCooldowns(request *proto.CooldownOptimizerRequest) *proto.CooldownOptimizerResult {
+	return optimizeCooldowns(request)
+}
+
+/**
+ * Searches for the values of numeric player options which give the highest raid DPS.
+ */
+func OptimizeRotation(request *proto.RotationOptimizerRequest) *proto.RotationOptimizerResult {
+	return optimizeRotation(request)
+}
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestBadCustomItemsReturnError(t *testing.T) {
+	customItems := &proto.ItemDatabase{
+		Version: items.DatabaseVersion + 1,
+		Items:   []*proto.Item{{Id: 1, Name: "Custom Item"}},
+	}
+
+	simResult := RunRaidSim(&proto.RaidSimRequest{
+		Raid:        &proto.Raid{},
+		Encounter:   &proto.Encounter{Targets: []*proto.Target{{}}},
+		SimOptions:  &proto.SimOptions{Iterations: 1},
+		CustomItems: customItems,
+	})
+	if simResult.ErrorResult == "" {
+		t.Fatalf("Expected an error result from the raid sim")
+	}
+
+	statsResult := ComputeStats(&proto.ComputeStatsRequest{
+		Raid:        &proto.Raid{},
+		CustomItems: customItems,
+	})
+	if statsResult.ErrorResult == "" {
+		t.Fatalf("Expected an error result from compute stats")
+	}
+}
+
+func TestBadTalentsStringReturnsError(t *testing.T) {
+	raid := SinglePlayerRaidProto(&proto.Player{
+		Name:          "Bad Talents",
+		Class:         proto.Class_ClassMage,
+		Spec:          &proto.Player_Mage{Mage: &proto.Mage{}},
+		TalentsString: "9999999",
+	}, nil, nil)
+
+	simResult := RunRaidSim(&proto.RaidSimRequest{
+		Raid:       raid,
+		Encounter:  &proto.Encounter{Targets: []*proto.Target{{}}},
+		SimOptions: &proto.SimOptions{Iterations: 1},
+	})
+	if simResult.ErrorResult == "" {
+		t.Fatalf("Expected an error result from the raid sim")
+	}
+
+	statsResult := ComputeStats(&proto.ComputeStatsRequest{
+		Raid: raid,
+	})
+	if statsResult.ErrorResult == "" {
+		t.Fatalf("Expected an error result from compute stats")
+	}
+	if raid.Parties[0].Players[0].GetMage().Talents != nil {
+		t.Fatalf("Validating talents should not modify the request")
+	}
+}
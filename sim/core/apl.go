@@ -0,0 +1,328 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// How long to wait before checking the APL again, when none of its actions
+// can be used.
+const aplRetryDelay = time.Millisecond * 100
+
+// An action which can be used by an APL rotation. Each spec registers the
+// actions it supports.
+type APLActionConfig struct {
+	ActionID ActionID
+
+	// Optional. Used by CooldownRemaining conditions.
+	CooldownID CooldownID
+
+	// Optional. Returns the time remaining on this action's DoT on the target,
+	// for DotRemainingTime conditions.
+	DotRemaining func(sim *Simulation, target *Target) time.Duration
+
+	// Uses the action on the target. Returns false if it couldn't be used,
+	// e.g. because of mana or cooldowns.
+	Execute func(sim *Simulation, target *Target) bool
+}
+
+type aplValue func(sim *Simulation, target *Target) float64
+
+type aplCondition struct {
+	value      aplValue
+	comparison proto.APLCondition_Comparison
+	threshold  float64
+}
+
+func (condition *aplCondition) passes(sim *Simulation, target *Target) bool {
+	value := condition.value(sim, target)
+	switch condition.comparison {
+	case proto.APLCondition_LessThan:
+		return value < condition.threshold
+	case proto.APLCondition_LessThanOrEqual:
+		return value <= condition.threshold
+	case proto.APLCondition_GreaterThan:
+		return value > condition.threshold
+	case proto.APLCondition_GreaterThanOrEqual:
+		return value >= condition.threshold
+	case proto.APLCondition_Equal:
+		return value == condition.threshold
+	case proto.APLCondition_NotEqual:
+		return value != condition.threshold
+	}
+	return false
+}
+
+type aplAction struct {
+	config     *APLActionConfig
+	conditions []aplCondition
+}
+
+type aplRotation struct {
+	// The Character using this rotation.
+	character *Character
+
+	// User-specified priority list, or nil to use the spec's own rotation.
+	config *proto.APLRotation
+
+	// Actions registered by the spec.
+	registeredActions []*APLActionConfig
+
+	// Actions from config, in priority order.
+	actions []aplAction
+
+	// Set by specs which don't support APL rotations.
+	unsupportedSpecName string
+
+	// Problem with the config, found during finalize(). Reported when the sim
+	// is built, rather than panicking.
+	err error
+
+	// Whether finalize() has been called on this object.
+	finalized bool
+}
+
+func newAPLRotation(config *proto.APLRotation) aplRotation {
+	return aplRotation{
+		config: config,
+	}
+}
+
+// Registers an action for use by APL rotations. Specs should register all of
+// their actions, whether or not the player has an APL.
+func (apl *aplRotation) RegisterAPLAction(config APLActionConfig) {
+	if apl.finalized {
+		panic("APL actions may not be registered once finalized!")
+	}
+
+	if config.ActionID.IsEmptyAction() {
+		panic("APL action must have an ActionID!")
+	}
+
+	if config.Execute == nil {
+		panic("APL action must provide an Execute callback!")
+	}
+
+	apl.registeredActions = append(apl.registeredActions, &config)
+}
+
+func (apl *aplRotation) getRegisteredAction(actionID ActionID) *APLActionConfig {
+	for _, action := range apl.registeredActions {
+		if action.ActionID.SameAction(actionID) {
+			return action
+		}
+	}
+	return nil
+}
+
+// Major cooldowns aren't created until the first reset, so look them up in
+// the initial cooldowns instead.
+func (apl *aplRotation) getMajorCooldown(actionID ActionID) *MajorCooldown {
+	for i := range apl.character.initialMajorCooldowns {
+		if apl.character.initialMajorCooldowns[i].SameAction(actionID) {
+			return &apl.character.initialMajorCooldowns[i]
+		}
+	}
+	return nil
+}
+
+// Whether this character's rotation comes from an APL rather than the spec.
+func (apl *aplRotation) HasAPLRotation() bool {
+	return apl.config != nil
+}
+
+// Specs which don't support APL rotations should call this from their
+// constructor, so an APL isn't silently ignored.
+func (apl *aplRotation) RejectAPLRotation(specName string) {
+	apl.unsupportedSpecName = specName
+}
+
+// Returns the problem with this character's APL, if any. Only valid once
+// finalized.
+func (apl *aplRotation) validateAPLRotation() error {
+	return apl.err
+}
+
+func (apl *aplRotation) finalize(character *Character) {
+	if apl.finalized {
+		return
+	}
+	apl.finalized = true
+
+	apl.character = character
+
+	if apl.config == nil {
+		return
+	}
+
+	if apl.unsupportedSpecName != "" {
+		apl.err = fmt.Errorf("APL rotations are not supported for %s", apl.unsupportedSpecName)
+		return
+	}
+
+	apl.actions, apl.err = apl.newActions()
+}
+
+func (apl *aplRotation) newActions() ([]aplAction, error) {
+	actions := []aplAction{}
+	for i, actionConfig := range apl.config.Actions {
+		if actionConfig.Id == nil {
+			return nil, fmt.Errorf("APL action %d has no ID", i)
+		}
+		actionID := ProtoToActionID(*actionConfig.Id)
+		registeredAction := apl.getRegisteredAction(actionID)
+		if registeredAction == nil {
+			return nil, fmt.Errorf("no APL action registered for %s", actionID)
+		}
+
+		action := aplAction{
+			config: registeredAction,
+		}
+		for _, conditionConfig := range actionConfig.Conditions {
+			condition, err := apl.newCondition(conditionConfig)
+			if err != nil {
+				return nil, fmt.Errorf("invalid condition for %s: %s", actionID, err)
+			}
+			action.conditions = append(action.conditions, condition)
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+func (apl *aplRotation) newCondition(config *proto.APLCondition) (aplCondition, error) {
+	if config.Comparison == proto.APLCondition_ComparisonUnknown {
+		return aplCondition{}, fmt.Errorf("APL condition must have a comparison")
+	}
+
+	value, err := apl.newValue(config)
+	if err != nil {
+		return aplCondition{}, err
+	}
+
+	return aplCondition{
+		value:      value,
+		comparison: config.Comparison,
+		threshold:  config.Threshold,
+	}, nil
+}
+
+func (apl *aplRotation) newValue(config *proto.APLCondition) (aplValue, error) {
+	character := apl.character
+
+	actionID := ActionID{}
+	if config.Id != nil {
+		actionID = ProtoToActionID(*config.Id)
+	}
+
+	switch config.Value {
+	case proto.APLCondition_CurrentTime:
+		return func(sim *Simulation, target *Target) float64 {
+			return sim.CurrentTime.Seconds()
+		}, nil
+	case proto.APLCondition_RemainingTime:
+		return func(sim *Simulation, target *Target) float64 {
+			return sim.GetRemainingDuration().Seconds()
+		}, nil
+	case proto.APLCondition_CurrentMana:
+		return func(sim *Simulation, target *Target) float64 {
+			return character.CurrentMana()
+		}, nil
+	case proto.APLCondition_CurrentManaPercent:
+		return func(sim *Simulation, target *Target) float64 {
+			return character.CurrentManaPercent() * 100
+		}, nil
+	case proto.APLCondition_CurrentRage:
+		return func(sim *Simulation, target *Target) float64 {
+			return character.CurrentRage()
+		}, nil
+	case proto.APLCondition_CurrentEnergy:
+		return func(sim *Simulation, target *Target) float64 {
+			return character.CurrentEnergy()
+		}, nil
+	case proto.APLCondition_AuraRemainingTime:
+		return func(sim *Simulation, target *Target) float64 {
+			return character.auraTracker.remainingDurationByActionID(sim, actionID).Seconds()
+		}, nil
+	case proto.APLCondition_AuraStacks:
+		return func(sim *Simulation, target *Target) float64 {
+			return float64(character.auraTracker.numStacksByActionID(actionID))
+		}, nil
+	case proto.APLCondition_DebuffRemainingTime:
+		return func(sim *Simulation, target *Target) float64 {
+			return target.auraTracker.remainingDurationByActionID(sim, actionID).Seconds()
+		}, nil
+	case proto.APLCondition_DebuffStacks:
+		return func(sim *Simulation, target *Target) float64 {
+			return float64(target.auraTracker.numStacksByActionID(actionID))
+		}, nil
+	case proto.APLCondition_DotRemainingTime:
+		action := apl.getRegisteredAction(actionID)
+		if action == nil || action.DotRemaining == nil {
+			return nil, fmt.Errorf("no APL action with a DoT registered for %s", actionID)
+		}
+		return func(sim *Simulation, target *Target) float64 {
+			return action.DotRemaining(sim, target).Seconds()
+		}, nil
+	case proto.APLCondition_CooldownRemaining:
+		cooldownID := CooldownID(0)
+		if action := apl.getRegisteredAction(actionID); action != nil && action.CooldownID != 0 {
+			cooldownID = action.CooldownID
+		} else if mcd := apl.getMajorCooldown(actionID); mcd != nil {
+			cooldownID = mcd.CooldownID
+		} else {
+			return nil, fmt.Errorf("no cooldown found for %s", actionID)
+		}
+		return func(sim *Simulation, target *Target) float64 {
+			return character.GetRemainingCD(cooldownID, sim.CurrentTime).Seconds()
+		}, nil
+	case proto.APLCondition_TargetHealthPercent:
+		return func(sim *Simulation, target *Target) float64 {
+			return target.CurrentHealthPercent() * 100
+		}, nil
+	case proto.APLCondition_IsExecutePhase:
+		return func(sim *Simulation, target *Target) float64 {
+			if sim.IsExecutePhase() {
+				return 1
+			}
+			return 0
+		}, nil
+	}
+
+	return nil, fmt.Errorf("invalid APL condition value: %s", config.Value)
+}
+
+// Uses the first action in the APL whose conditions pass and which can be
+// used right now. If there is none, checks again after a short delay.
+//
+// Specs with an APL should call this from OnGCDReady instead of choosing
+// actions themselves.
+func (apl *aplRotation) DoAPLRotation(sim *Simulation) {
+	character := apl.character
+	target := character.CurrentTarget(sim)
+
+	for _, action := range apl.actions {
+		if action.config.CooldownID != 0 && character.IsOnCD(action.config.CooldownID, sim.CurrentTime) {
+			continue
+		}
+		if !action.conditionsPass(sim, target) {
+			continue
+		}
+		if action.config.Execute(sim, target) {
+			return
+		}
+	}
+
+	character.WaitUntil(sim, sim.CurrentTime+aplRetryDelay)
+}
+
+func (action *aplAction) conditionsPass(sim *Simulation, target *Target) bool {
+	for i := range action.conditions {
+		if !action.conditions[i].passes(sim, target) {
+			return false
+		}
+	}
+	return true
+}
@@ -66,6 +66,10 @@ type MeleeAbility struct {
 	// like Windfury. Many on-hit effects do not proc from phantom attacks, only regular attacks.
 	IsPhantom bool
 
+	// Speed of this ability's missile, in yards per second, or 0 if it hits
+	// immediately. Only used for ranged abilities.
+	MissileSpeed float64
+
 	// Internal field only, used to prevent pool objects from being used by
 	// multiple attacks simultaneously.
 	objectInUse bool
@@ -278,13 +282,14 @@ func (ahe *AbilityHitEffect) WhiteHitTableResult(sim *Simulation, ability *Activ
 	character := ability.Character
 
 	roll := sim.RandomFloat("auto attack")
+	table := ahe.Target.AttackTable(ahe.WeaponSkill(character))
 
 	// Miss
-	missChance := ahe.Target.MissChance
+	missChance := table.MissChance
 	if ahe.IsWhiteHit && !ahe.IsRanged() && character.AutoAttacks.IsDualWielding {
 		missChance += 0.19
 	}
-	hitBonus := ((character.stats[stats.MeleeHit] + ahe.BonusHitRating) / (MeleeHitRatingPerHitChance * 100)) - ahe.Target.HitSuppression
+	hitBonus := ((character.stats[stats.MeleeHit] + ahe.BonusHitRating) / (MeleeHitRatingPerHitChance * 100)) - table.HitSuppression
 	if hitBonus > 0 {
 		missChance = MaxFloat(0, missChance-hitBonus)
 	}
@@ -296,7 +301,7 @@ func (ahe *AbilityHitEffect) WhiteHitTableResult(sim *Simulation, ability *Activ
 
 	if !ahe.IsRanged() { // Ranged hits can't be dodged/glance, and are always 2-roll
 		// Dodge
-		dodge := ahe.Target.Dodge
+		dodge := table.Dodge
 		expertisePercentage := MinFloat(math.Floor((character.stats[stats.Expertise]+ahe.BonusExpertiseRating)/(ExpertisePerQuarterPercentReduction))/400, dodge)
 		chance += dodge - expertisePercentage
 		if roll < chance {
@@ -316,13 +321,13 @@ func (ahe *AbilityHitEffect) WhiteHitTableResult(sim *Simulation, ability *Activ
 		// If we actually implement blocks, ranged hits can be blocked.
 
 		// Glance
-		chance += ahe.Target.Glance
+		chance += table.Glance
 		if roll < chance {
 			return MeleeHitTypeGlance
 		}
 
 		// Crit
-		critChance := ((character.stats[stats.MeleeCrit] + ahe.BonusCritRating) / (MeleeCritRatingPerCritChance * 100)) - ahe.Target.CritSuppression
+		critChance := ((character.stats[stats.MeleeCrit] + ahe.BonusCritRating) / (MeleeCritRatingPerCritChance * 100)) - table.CritSuppression
 		chance += critChance
 		if roll < chance {
 			return MeleeHitTypeCrit
@@ -387,9 +392,8 @@ func (ahe *AbilityHitEffect) calculateDamage(sim *Simulation, ability *ActiveMel
 
 	// If this is a yellow attack, need a 2nd roll to decide crit. Otherwise just use existing hit result.
 	if !ahe.AbilityEffect.IsWhiteHit || ahe.IsRanged() {
-		skill := 350.0
-		level := float64(ahe.Target.Level)
-		critChance := ((character.stats[stats.MeleeCrit] + ahe.BonusCritRating) / (MeleeCritRatingPerCritChance * 100)) + ((skill - (level * 5)) * 0.002) - 0.018
+		critSuppression := ahe.Target.AttackTable(ahe.WeaponSkill(character)).CritSuppression
+		critChance := ((character.stats[stats.MeleeCrit] + ahe.BonusCritRating) / (MeleeCritRatingPerCritChance * 100)) - critSuppression
 
 		roll := sim.RandomFloat("weapon swing")
 		if roll < critChance {
@@ -411,7 +415,7 @@ func (ahe *AbilityHitEffect) calculateDamage(sim *Simulation, ability *ActiveMel
 	}
 
 	// Apply all other effect multipliers.
-	dmg *= ahe.DamageMultiplier * ahe.StaticDamageMultiplier
+	dmg *= ahe.DamageMultiplier * ahe.StaticDamageMultiplier * ahe.Target.DamageTakenMultiplier
 
 	ahe.Damage = dmg
 }
@@ -422,6 +426,17 @@ func (ahe *AbilityHitEffect) IsWeaponHit() bool {
 	return ahe.WeaponInput.DamageMultiplier != 0 || ahe.WeaponInput.CalculateDamage != nil
 }
 
+// Returns the character's weapon skill with the weapon used by this hit effect.
+func (ahe *AbilityHitEffect) WeaponSkill(character *Character) float64 {
+	if ahe.IsRanged() {
+		return BaseWeaponSkill + character.PseudoStats.BonusRangedWeaponSkill
+	} else if ahe.IsOH() {
+		return BaseWeaponSkill + character.PseudoStats.BonusOHWeaponSkill
+	} else {
+		return BaseWeaponSkill + character.PseudoStats.BonusMHWeaponSkill
+	}
+}
+
 // Returns whether this hit effect is associated with the main-hand weapon.
 func (ahe *AbilityHitEffect) IsMH() bool {
 	return !ahe.WeaponInput.IsOH && !ahe.WeaponInput.IsRanged
@@ -486,27 +501,39 @@ func (ability *ActiveMeleeAbility) Attack(sim *Simulation) bool {
 		}
 	}
 
-	if len(ability.Effects) == 0 {
-		ability.Effect.performAttack(sim, ability)
+	travelTime := sim.MissileTravelTime(ability.MissileSpeed)
+	if travelTime > 0 {
+		ability.launchMissile(sim, travelTime)
 	} else {
-		for i, _ := range ability.Effects {
-			ahe := &ability.Effects[i]
-			ahe.performAttack(sim, ability)
-		}
+		ability.performAttacks(sim)
 	}
 
 	if ability.GCD != 0 {
 		gcdCD := MaxDuration(ability.CalculatedGCD(ability.Character), ability.CastTime)
+		gcdCD += ability.Character.ActionDelay(sim)
 		ability.Character.SetGCDTimer(sim, sim.CurrentTime+gcdCD)
 	}
 
 	if ability.Cooldown != 0 {
 		ability.Character.SetCD(ability.ActionID.CooldownID, sim.CurrentTime+ability.Cooldown)
 	}
-	ability.Character.Metrics.AddMeleeAbility(ability)
+	if travelTime == 0 {
+		ability.Character.Metrics.AddMeleeAbility(ability)
+	}
 	return true
 }
 
+func (ability *ActiveMeleeAbility) performAttacks(sim *Simulation) {
+	if len(ability.Effects) == 0 {
+		ability.Effect.performAttack(sim, ability)
+	} else {
+		for i, _ := range ability.Effects {
+			ahe := &ability.Effects[i]
+			ahe.performAttack(sim, ability)
+		}
+	}
+}
+
 func (ahe *AbilityHitEffect) performAttack(sim *Simulation, ability *ActiveMeleeAbility) {
 	ability.Character.OnBeforeMeleeHit(sim, ability, ahe)
 	ahe.Target.OnBeforeMeleeHit(sim, ability, ahe)
@@ -529,6 +556,7 @@ func (ahe *AbilityHitEffect) performAttack(sim *Simulation, ability *ActiveMelee
 		ability.Blocks++
 	}
 	ability.TotalDamage += ahe.Damage
+	ahe.Target.takeDamage(sim, ability.Character, ability.ActionID, ability.SpellSchool, ahe.Damage)
 	ability.TotalThreat += (ahe.Damage + ahe.FlatThreatBonus) * ahe.ThreatMultiplier * ability.Character.PseudoStats.ThreatMultiplier
 
 	if sim.Log != nil {
@@ -700,7 +728,7 @@ func (aa *AutoAttacks) reset(sim *Simulation) {
 	aa.RangedSwingInProgress = false
 	aa.RangedCast.OnCastComplete = func(sim *Simulation, cast *Cast) {
 		ama := aa.RangedAuto
-		ama.Effect.Target = sim.GetPrimaryTarget()
+		ama.Effect.Target = aa.character.CurrentTarget(sim)
 		ama.Attack(sim)
 		aa.RangedSwingInProgress = false
 		aa.agent.OnAutoAttack(sim, &ama)
@@ -721,7 +749,14 @@ func (aa *AutoAttacks) resetAutoSwing(sim *Simulation) {
 	pa.NextActionAt = 0 // First auto is always at 0
 
 	pa.OnAction = func(sim *Simulation) {
-		aa.SwingMelee(sim, sim.GetPrimaryTarget())
+		if sim.IsDowntime() {
+			// Resume swinging once the downtime ends.
+			pa.NextActionAt = MaxDuration(aa.NextAttackAt(), sim.DowntimeEndsAt())
+			sim.AddPendingAction(pa)
+			return
+		}
+
+		aa.SwingMelee(sim, aa.character.CurrentTarget(sim))
 		pa.NextActionAt = aa.NextAttackAt()
 
 		// Cancelled means we made a new one because of a swing speed change.
@@ -1024,6 +1059,19 @@ func (template *MeleeAbilityTemplate) Apply(newAction *ActiveMeleeAbility) {
 	*newAction = template.template
 	newAction.Effects = template.effects
 	copy(newAction.Effects, template.template.Effects)
+
+	// AoE abilities shouldn't hit targets which can't be attacked in the
+	// current encounter phase.
+	numActive := 0
+	for i := range newAction.Effects {
+		if isAoeTargetActive(newAction.Effects[i].Target) {
+			newAction.Effects[numActive] = newAction.Effects[i]
+			numActive++
+		}
+	}
+	if numActive > 0 {
+		newAction.Effects = newAction.Effects[:numActive]
+	}
 }
 
 // Takes in a cast template and returns a template, so you don't need to keep track of which things to allocate yourself.
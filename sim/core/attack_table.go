@@ -0,0 +1,70 @@
+package core
+
+import (
+	"math"
+)
+
+// Weapon skill of a character with no weapon skill bonuses.
+const BaseWeaponSkill = CharacterLevel * 5
+
+// Base chance for a spell to hit a target 0, 1, 2, or 3 levels above the caster.
+var spellHitChanceByLevelDifference = [4]float64{0.96, 0.95, 0.94, 0.83}
+
+// Combat table values for an attacker against a target, derived from the
+// difference between the target's defense and the attacker's weapon skill.
+type AttackTable struct {
+	MissChance      float64
+	HitSuppression  float64 // Hit bonus which is ignored, when skill difference is more than 10.
+	CritSuppression float64
+	Dodge           float64
+	Glance          float64
+}
+
+// Returns the combat table for an attacker of the given level and weapon skill
+// against a mob of the given level.
+func NewAttackTable(attackerLevel int32, weaponSkill float64, targetLevel int32) AttackTable {
+	defense := float64(targetLevel * 5)
+	skillDifference := defense - weaponSkill
+
+	table := AttackTable{}
+	if skillDifference > 10 {
+		table.MissChance = 0.05 + skillDifference*0.002
+		table.HitSuppression = (skillDifference - 10) * 0.002
+	} else if skillDifference >= 0 {
+		table.MissChance = 0.05 + skillDifference*0.001
+	} else {
+		table.MissChance = MaxFloat(0, 0.05+skillDifference*0.0004)
+	}
+
+	if skillDifference >= 0 {
+		table.Dodge = 0.05 + skillDifference*0.001
+		table.CritSuppression = skillDifference * 0.002
+		if targetLevel-attackerLevel >= 3 {
+			// Additional crit suppression against bosses.
+			table.CritSuppression += 0.018
+		}
+	} else {
+		table.Dodge = MaxFloat(0, 0.05+skillDifference*0.0004)
+		table.CritSuppression = skillDifference * 0.0004
+	}
+
+	// Weapon skill above the attacker's level cap doesn't reduce glancing blows.
+	glanceSkillDifference := defense - MinFloat(weaponSkill, float64(attackerLevel*5))
+	table.Glance = math.Max(0.06+glanceSkillDifference*0.012, 0)
+
+	return table
+}
+
+// Returns the base chance (before hit rating) for a spell cast by an attacker
+// of the given level to hit a mob of the given level.
+func SpellHitChance(attackerLevel int32, targetLevel int32) float64 {
+	levelDifference := targetLevel - attackerLevel
+	if levelDifference < 0 {
+		return MinFloat(0.99, spellHitChanceByLevelDifference[0]-0.01*float64(levelDifference))
+	} else if levelDifference < int32(len(spellHitChanceByLevelDifference)) {
+		return spellHitChanceByLevelDifference[levelDifference]
+	} else {
+		// Each level beyond +3 adds another 11% miss chance.
+		return MaxFloat(0, spellHitChanceByLevelDifference[3]-0.11*float64(levelDifference-3))
+	}
+}
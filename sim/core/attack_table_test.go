@@ -0,0 +1,68 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestAttackTableByLevel(t *testing.T) {
+	testCases := []struct {
+		targetLevel int32
+		weaponSkill float64
+		expected    AttackTable
+	}{
+		{70, 350, AttackTable{MissChance: 0.05, Dodge: 0.05, Glance: 0.06}},
+		{71, 350, AttackTable{MissChance: 0.055, Dodge: 0.055, CritSuppression: 0.01, Glance: 0.12}},
+		{72, 350, AttackTable{MissChance: 0.06, Dodge: 0.06, CritSuppression: 0.02, Glance: 0.18}},
+		{73, 350, AttackTable{MissChance: 0.08, HitSuppression: 0.01, Dodge: 0.065, CritSuppression: 0.048, Glance: 0.24}},
+
+		// +5 weapon skill from racials.
+		{70, 355, AttackTable{MissChance: 0.048, Dodge: 0.048, CritSuppression: -0.002, Glance: 0.06}},
+		{71, 355, AttackTable{MissChance: 0.05, Dodge: 0.05, Glance: 0.12}},
+		{72, 355, AttackTable{MissChance: 0.055, Dodge: 0.055, CritSuppression: 0.01, Glance: 0.18}},
+		{73, 355, AttackTable{MissChance: 0.06, Dodge: 0.06, CritSuppression: 0.038, Glance: 0.24}},
+	}
+
+	for _, tc := range testCases {
+		actual := NewAttackTable(CharacterLevel, tc.weaponSkill, tc.targetLevel)
+		if !attackTablesEqual(actual, tc.expected) {
+			t.Errorf("Level %d target with %0.0f weapon skill: expected %+v, got %+v", tc.targetLevel, tc.weaponSkill, tc.expected, actual)
+		}
+	}
+}
+
+func TestSpellHitChanceByLevel(t *testing.T) {
+	expected := map[int32]float64{
+		70: 0.96,
+		71: 0.95,
+		72: 0.94,
+		73: 0.83,
+	}
+
+	for level, hitChance := range expected {
+		if actual := SpellHitChance(CharacterLevel, level); math.Abs(actual-hitChance) > 0.00001 {
+			t.Errorf("Level %d target: expected spell hit chance %0.2f, got %0.2f", level, hitChance, actual)
+		}
+	}
+}
+
+func attackTablesEqual(a AttackTable, b AttackTable) bool {
+	const epsilon = 0.00001
+	return math.Abs(a.MissChance-b.MissChance) < epsilon &&
+		math.Abs(a.HitSuppression-b.HitSuppression) < epsilon &&
+		math.Abs(a.CritSuppression-b.CritSuppression) < epsilon &&
+		math.Abs(a.Dodge-b.Dodge) < epsilon &&
+		math.Abs(a.Glance-b.Glance) < epsilon
+}
+
+func TestTargetLevelOption(t *testing.T) {
+	target := NewTarget(proto.Target{Level: 70}, 0)
+	if table := target.AttackTable(BaseWeaponSkill); math.Abs(table.MissChance-0.05) > 0.00001 {
+		t.Fatalf("Expected level 70 target miss chance of 5%%, got %0.3f", table.MissChance)
+	}
+	if math.Abs(target.SpellHitChance()-0.96) > 0.00001 {
+		t.Fatalf("Expected level 70 target spell hit chance of 96%%, got %0.3f", target.SpellHitChance())
+	}
+}
@@ -77,6 +77,7 @@ type Aura struct {
 	onSpellMissIndex            int32 // Position of this aura's index in the sim.onSpellMissIDs array.
 	onBeforePeriodicDamageIndex int32 // Position of this aura's index in the sim.onBeforePeriodicDamageIDs array.
 	onPeriodicDamageIndex       int32 // Position of this aura's index in the sim.onPeriodicDamageIDs array.
+	onDamageTakenIndex          int32 // Position of this aura's index in the sim.onDamageTakenIDs array.
 	OnMeleeAttackIndex          int32 // Position of this aura's index in the sim.OnMeleeAttack array.
 	OnBeforeMeleeIndex          int32 // Position of this aura's index in the sim.OnBeforeMelee array.
 	OnBeforeMeleeHitIndex       int32 // Position of this aura's index in the sim.OnBeforeMeleeHit array.
@@ -110,6 +111,10 @@ type Aura struct {
 	// Invoked when a dot tick occurs, after damage is calculated.
 	OnPeriodicDamage OnPeriodicDamage
 
+	// Invoked when the owner of this aura takes damage from an enemy, and
+	// survives.
+	OnDamageTaken OnDamageTaken
+
 	// Invoked after a melee hit has occured (could be auto or skill).
 	OnMeleeAttack OnMeleeAttack
 
@@ -170,6 +175,7 @@ type auraTracker struct {
 	onSpellMissIDs            []AuraID
 	onBeforePeriodicDamageIDs []AuraID
 	onPeriodicDamageIDs       []AuraID
+	onDamageTakenIDs          []AuraID
 	onMeleeAttackIDs          []AuraID
 	onBeforeMeleeIDs          []AuraID
 	onBeforeMeleeHitIDs       []AuraID
@@ -196,6 +202,7 @@ func newAuraTracker(useDebuffIDs bool) auraTracker {
 		onSpellMissIDs:            make([]AuraID, 0, 16),
 		onBeforePeriodicDamageIDs: make([]AuraID, 0, 16),
 		onPeriodicDamageIDs:       make([]AuraID, 0, 16),
+		onDamageTakenIDs:          make([]AuraID, 0, 16),
 		onMeleeAttackIDs:          make([]AuraID, 0, 16),
 		onBeforeMeleeIDs:          make([]AuraID, 0, 16),
 		onBeforeMeleeHitIDs:       make([]AuraID, 0, 16),
@@ -244,6 +251,7 @@ func (at *auraTracker) reset(sim *Simulation) {
 	at.onSpellMissIDs = at.onSpellMissIDs[:0]
 	at.onBeforePeriodicDamageIDs = at.onBeforePeriodicDamageIDs[:0]
 	at.onPeriodicDamageIDs = at.onPeriodicDamageIDs[:0]
+	at.onDamageTakenIDs = at.onDamageTakenIDs[:0]
 	at.onMeleeAttackIDs = at.onMeleeAttackIDs[:0]
 	at.onBeforeMeleeIDs = at.onBeforeMeleeIDs[:0]
 	at.onBeforeMeleeHitIDs = at.onBeforeMeleeHitIDs[:0]
@@ -331,6 +339,7 @@ func (at *auraTracker) ReplaceAura(sim *Simulation, newAura Aura) {
 	newAura.onSpellMissIndex = old.onSpellMissIndex
 	newAura.onBeforePeriodicDamageIndex = old.onBeforePeriodicDamageIndex
 	newAura.onPeriodicDamageIndex = old.onPeriodicDamageIndex
+	newAura.onDamageTakenIndex = old.onDamageTakenIndex
 	newAura.OnMeleeAttackIndex = old.OnMeleeAttackIndex
 	newAura.OnBeforeMeleeIndex = old.OnBeforeMeleeIndex
 	newAura.OnBeforeMeleeHitIndex = old.OnBeforeMeleeHitIndex
@@ -391,6 +400,11 @@ func (at *auraTracker) AddAura(sim *Simulation, newAura Aura) {
 		at.onPeriodicDamageIDs = append(at.onPeriodicDamageIDs, newAura.ID)
 	}
 
+	if newAura.OnDamageTaken != nil {
+		at.auras[newAura.ID].onDamageTakenIndex = int32(len(at.onDamageTakenIDs))
+		at.onDamageTakenIDs = append(at.onDamageTakenIDs, newAura.ID)
+	}
+
 	if newAura.OnMeleeAttack != nil {
 		at.auras[newAura.ID].OnMeleeAttackIndex = int32(len(at.onMeleeAttackIDs))
 		at.onMeleeAttackIDs = append(at.onMeleeAttackIDs, newAura.ID)
@@ -487,6 +501,14 @@ func (at *auraTracker) RemoveAura(sim *Simulation, id AuraID) {
 		}
 	}
 
+	if at.auras[id].OnDamageTaken != nil {
+		removeOnDamageTaken := at.auras[id].onDamageTakenIndex
+		at.onDamageTakenIDs = removeBySwappingToBack(at.onDamageTakenIDs, removeOnDamageTaken)
+		if removeOnDamageTaken < int32(len(at.onDamageTakenIDs)) {
+			at.auras[at.onDamageTakenIDs[removeOnDamageTaken]].onDamageTakenIndex = removeOnDamageTaken
+		}
+	}
+
 	if at.auras[id].OnMeleeAttack != nil {
 		removeOnMeleeAttack := at.auras[id].OnMeleeAttackIndex
 		at.onMeleeAttackIDs = removeBySwappingToBack(at.onMeleeAttackIDs, removeOnMeleeAttack)
@@ -558,6 +580,31 @@ func (at *auraTracker) RemainingAuraDuration(sim *Simulation, id AuraID) time.Du
 	}
 }
 
+// Returns the ID of an active aura with the given action, ignoring tags, or
+// false if there is none.
+func (at *auraTracker) findAuraByActionID(actionID ActionID) (AuraID, bool) {
+	for _, id := range at.activeAuraIDs {
+		if at.auras[id].ActionID.SameActionIgnoreTag(actionID) {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+func (at *auraTracker) numStacksByActionID(actionID ActionID) int32 {
+	if id, ok := at.findAuraByActionID(actionID); ok {
+		return at.NumStacks(id)
+	}
+	return 0
+}
+
+func (at *auraTracker) remainingDurationByActionID(sim *Simulation, actionID ActionID) time.Duration {
+	if id, ok := at.findAuraByActionID(actionID); ok {
+		return at.RemainingAuraDuration(sim, id)
+	}
+	return 0
+}
+
 func (at *auraTracker) IsOnCD(id CooldownID, currentTime time.Duration) bool {
 	return at.cooldowns[id] > currentTime
 }
@@ -630,6 +677,13 @@ func (at *auraTracker) OnPeriodicDamage(sim *Simulation, spellCast *SpellCast, s
 	}
 }
 
+// Invokes the OnDamageTaken event for all tracked Auras.
+func (at *auraTracker) OnDamageTaken(sim *Simulation, character *Character, damage float64) {
+	for _, id := range at.onDamageTakenIDs {
+		at.auras[id].OnDamageTaken(sim, character, damage)
+	}
+}
+
 func (at *auraTracker) OnMeleeAttack(sim *Simulation, ability *ActiveMeleeAbility, hitEffect *AbilityHitEffect) {
 	for _, id := range at.onMeleeAttackIDs {
 		at.auras[id].OnMeleeAttack(sim, ability, hitEffect)
@@ -0,0 +1,285 @@
+package core
+
+import (
+	"math"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Blessings received by every player of a spec.
+type specBlessings struct {
+	kings     bool
+	salvation bool
+	might     proto.TristateEffect
+	wisdom    proto.TristateEffect
+}
+
+type BlessingsSolution struct {
+	// Blessing from each paladin for each spec, in the same format as the raid sim UI.
+	Assignments *proto.BlessingsAssignments
+
+	// Buffs for each player after applying the assignments, by raid index.
+	// Nil for empty slots.
+	PlayerBuffs []*proto.IndividualBuffs
+
+	// Raid DPS with no blessings, and with the assigned blessings.
+	BaselineDps float64
+	Dps         float64
+
+	NumSims int32
+}
+
+// Chooses blessings for each spec which maximize raid DPS.
+//
+// The value of each blessing is measured by simming the raid with that
+// blessing on a single spec, and comparing against a raid with no blessings.
+// Blessings are then assigned for each spec independently, with each paladin
+// giving one blessing and no blessing given twice. Specs with a threat-limited
+// player always get Salvation, if there are any paladins.
+func SolveBlessings(request *proto.RaidSimRequest, paladins []*proto.PaladinTalents, threatLimitedRaidIndices []int32) BlessingsSolution {
+	solver := newBlessingsSolver(request, paladins, threatLimitedRaidIndices)
+
+	baselineDps := solver.evaluate(nil)
+	solver.measureGains(baselineDps)
+
+	assignments := &proto.BlessingsAssignments{}
+	for range paladins {
+		assignments.Paladins = append(assignments.Paladins, &proto.BlessingsAssignment{
+			Blessings: make([]proto.Blessings, len(proto.Spec_name)),
+		})
+	}
+
+	blessings := make(map[proto.Spec]specBlessings)
+	for spec := range solver.gains {
+		specAssignment := solver.assignSpec(spec)
+		for paladinIdx, blessing := range specAssignment {
+			assignments.Paladins[paladinIdx].Blessings[spec] = blessing
+		}
+		blessings[spec] = solver.toSpecBlessings(specAssignment)
+	}
+
+	raid := solver.buildRaid(blessings)
+	playerBuffs := make([]*proto.IndividualBuffs, len(raid.Parties)*maxPartySize)
+	for partyIdx, party := range raid.Parties {
+		for playerIdx, player := range party.Players {
+			if _, ok := PlayerProtoToSpec(player); ok {
+				playerBuffs[partyIdx*maxPartySize+playerIdx] = player.Buffs
+			}
+		}
+	}
+
+	return BlessingsSolution{
+		Assignments: assignments,
+		PlayerBuffs: playerBuffs,
+		BaselineDps: baselineDps,
+		Dps:         solver.evaluate(blessings),
+		NumSims:     solver.numSims,
+	}
+}
+
+type blessingsSolver struct {
+	request    *proto.RaidSimRequest
+	simOptions *proto.SimOptions
+	paladins   []*proto.PaladinTalents
+
+	threatLimitedSpecs map[proto.Spec]bool
+
+	// DPS gained from giving a blessing to a spec, for each spec in the raid.
+	gains map[proto.Spec]map[specBlessings]float64
+
+	numSims int32
+}
+
+func newBlessingsSolver(request *proto.RaidSimRequest, paladins []*proto.PaladinTalents, threatLimitedRaidIndices []int32) *blessingsSolver {
+	solver := &blessingsSolver{
+		request:            request,
+		simOptions:         optimizerSimOptions(request.SimOptions),
+		paladins:           paladins,
+		threatLimitedSpecs: make(map[proto.Spec]bool),
+		gains:              make(map[proto.Spec]map[specBlessings]float64),
+	}
+
+	for _, party := range request.Raid.Parties {
+		if party == nil {
+			continue
+		}
+		for _, player := range party.Players {
+			if spec, ok := PlayerProtoToSpec(player); ok {
+				solver.gains[spec] = make(map[specBlessings]float64)
+			}
+		}
+	}
+
+	for _, raidIndex := range threatLimitedRaidIndices {
+		partyIdx := int(raidIndex) / maxPartySize
+		playerIdx := int(raidIndex) % maxPartySize
+		if partyIdx >= len(request.Raid.Parties) || playerIdx >= len(request.Raid.Parties[partyIdx].GetPlayers()) {
+			continue
+		}
+		if spec, ok := PlayerProtoToSpec(request.Raid.Parties[partyIdx].Players[playerIdx]); ok {
+			solver.threatLimitedSpecs[spec] = true
+		}
+	}
+
+	return solver
+}
+
+// Sims each blessing the paladins can give on each spec, one at a time.
+func (solver *blessingsSolver) measureGains(baselineDps float64) {
+	options := map[specBlessings]bool{}
+	for _, paladin := range solver.paladins {
+		for _, blessing := range []proto.Blessings{proto.Blessings_BlessingOfKings, proto.Blessings_BlessingOfMight, proto.Blessings_BlessingOfWisdom} {
+			if option, ok := blessingFromPaladin(paladin, blessing); ok {
+				options[option] = true
+			}
+		}
+	}
+
+	for spec := range solver.gains {
+		for option := range options {
+			solver.gains[spec][option] = solver.evaluate(map[proto.Spec]specBlessings{spec: option}) - baselineDps
+		}
+	}
+}
+
+// Returns the blessing the paladin gives when assigned the given blessing, or
+// false if they can't give it.
+func blessingFromPaladin(paladin *proto.PaladinTalents, blessing proto.Blessings) (specBlessings, bool) {
+	switch blessing {
+	case proto.Blessings_BlessingOfKings:
+		return specBlessings{kings: true}, paladin.BlessingOfKings
+	case proto.Blessings_BlessingOfSalvation:
+		return specBlessings{salvation: true}, true
+	case proto.Blessings_BlessingOfMight:
+		if paladin.ImprovedBlessingOfMight == 5 {
+			return specBlessings{might: proto.TristateEffect_TristateEffectImproved}, true
+		}
+		return specBlessings{might: proto.TristateEffect_TristateEffectRegular}, true
+	case proto.Blessings_BlessingOfWisdom:
+		if paladin.ImprovedBlessingOfWisdom == 2 {
+			return specBlessings{wisdom: proto.TristateEffect_TristateEffectImproved}, true
+		}
+		return specBlessings{wisdom: proto.TristateEffect_TristateEffectRegular}, true
+	}
+	return specBlessings{}, false
+}
+
+// Tries every combination of blessings from the paladins for the spec, and
+// returns the one with the highest total gain.
+func (solver *blessingsSolver) assignSpec(spec proto.Spec) []proto.Blessings {
+	needsSalvation := solver.threatLimitedSpecs[spec]
+	// Tried in this order, so ties go to the earlier blessing.
+	candidates := []proto.Blessings{
+		proto.Blessings_BlessingOfKings,
+		proto.Blessings_BlessingOfMight,
+		proto.Blessings_BlessingOfWisdom,
+		proto.Blessings_BlessingOfSalvation,
+		proto.Blessings_BlessingUnknown,
+	}
+
+	current := make([]proto.Blessings, len(solver.paladins))
+	best := make([]proto.Blessings, len(solver.paladins))
+	bestValue := math.Inf(-1)
+	used := map[proto.Blessings]bool{}
+
+	var search func(paladinIdx int, value float64)
+	search = func(paladinIdx int, value float64) {
+		if paladinIdx == len(solver.paladins) {
+			if needsSalvation && len(solver.paladins) > 0 && !used[proto.Blessings_BlessingOfSalvation] {
+				return
+			}
+			if value > bestValue {
+				bestValue = value
+				copy(best, current)
+			}
+			return
+		}
+
+		for _, blessing := range candidates {
+			if blessing != proto.Blessings_BlessingUnknown && used[blessing] {
+				continue
+			}
+			if blessing == proto.Blessings_BlessingOfSalvation && !needsSalvation {
+				continue
+			}
+
+			gain := 0.0
+			if blessing != proto.Blessings_BlessingUnknown {
+				option, ok := blessingFromPaladin(solver.paladins[paladinIdx], blessing)
+				if !ok {
+					continue
+				}
+				// Blessings never lower DPS, so negative gains are just noise.
+				gain = math.Max(0, solver.gains[spec][option])
+			}
+
+			current[paladinIdx] = blessing
+			used[blessing] = true
+			search(paladinIdx+1, value+gain)
+			used[blessing] = false
+		}
+	}
+	search(0, 0)
+
+	return best
+}
+
+func (solver *blessingsSolver) toSpecBlessings(assignment []proto.Blessings) specBlessings {
+	blessings := specBlessings{}
+	for paladinIdx, blessing := range assignment {
+		option, ok := blessingFromPaladin(solver.paladins[paladinIdx], blessing)
+		if !ok {
+			continue
+		}
+		blessings.kings = blessings.kings || option.kings
+		blessings.salvation = blessings.salvation || option.salvation
+		if option.might > blessings.might {
+			blessings.might = option.might
+		}
+		if option.wisdom > blessings.wisdom {
+			blessings.wisdom = option.wisdom
+		}
+	}
+	return blessings
+}
+
+// Runs a raid sim with the given blessings and returns the average raid DPS.
+func (solver *blessingsSolver) evaluate(blessings map[proto.Spec]specBlessings) float64 {
+	solver.numSims++
+	result := RunRaidSim(&proto.RaidSimRequest{
+		Raid:            solver.buildRaid(blessings),
+		Encounter:       solver.request.Encounter,
+		SimOptions:      solver.simOptions,
+		CustomItems:     solver.request.CustomItems,
+		PresetEncounter: solver.request.PresetEncounter,
+	})
+	return result.RaidMetrics.Dps.Avg
+}
+
+// Returns a copy of the input raid, with blessings replaced by the given ones.
+// Specs missing from blessings get no blessings.
+func (solver *blessingsSolver) buildRaid(blessings map[proto.Spec]specBlessings) *proto.Raid {
+	raid := googleProto.Clone(solver.request.Raid).(*proto.Raid)
+	for _, party := range raid.Parties {
+		if party == nil {
+			continue
+		}
+		for _, player := range party.Players {
+			spec, ok := PlayerProtoToSpec(player)
+			if !ok {
+				continue
+			}
+			if player.Buffs == nil {
+				player.Buffs = &proto.IndividualBuffs{}
+			}
+
+			assigned := blessings[spec]
+			player.Buffs.BlessingOfKings = assigned.kings
+			player.Buffs.BlessingOfSalvation = assigned.salvation
+			player.Buffs.BlessingOfMight = assigned.might
+			player.Buffs.BlessingOfWisdom = assigned.wisdom
+		}
+	}
+	return raid
+}
@@ -523,6 +523,8 @@ func registerExternalConsecutiveCDApproximation(agent Agent, config externalCons
 	})
 }
 
+const SpellIDBloodlust int32 = 2825
+
 var BloodlustAuraID = NewAuraID()
 var sharedBloodlustCooldownID = NewCooldownID() // Different from shaman bloodlust CD.
 const BloodlustDuration = time.Second * 40
@@ -532,7 +534,7 @@ func registerBloodlustCD(agent Agent, numBloodlusts int32) {
 	registerExternalConsecutiveCDApproximation(
 		agent,
 		externalConsecutiveCDApproximation{
-			ActionID:         ActionID{SpellID: 2825, Tag: -1},
+			ActionID:         ActionID{SpellID: SpellIDBloodlust, Tag: -1},
 			AuraID:           BloodlustAuraID,
 			CooldownID:       sharedBloodlustCooldownID,
 			CooldownPriority: CooldownPriorityBloodlust,
@@ -564,7 +566,7 @@ func AddBloodlustAura(sim *Simulation, character *Character, actionTag int32) {
 
 	character.AddAura(sim, Aura{
 		ID:       BloodlustAuraID,
-		ActionID: ActionID{SpellID: 2825, Tag: actionTag},
+		ActionID: ActionID{SpellID: SpellIDBloodlust, Tag: actionTag},
 		Expires:  sim.CurrentTime + BloodlustDuration,
 		OnExpire: func(sim *Simulation) {
 			character.PseudoStats.CastSpeedMultiplier *= inverseBonus
@@ -738,10 +740,63 @@ func ManaTideTotemAmount(character *Character) float64 {
 	return character.MaxMana()*0.24 - 120
 }
 
+// Tracks the mana a group of characters expects from Mana Tide Totem for the
+// rest of the fight, and when it should first be used. This is shared by
+// real resto shamans and the PartyBuffs.ManaTideTotems approximation.
+type ManaTideTotemSchedule struct {
+	// Characters who receive the buff. Those without a mana bar are skipped.
+	characters []*Character
+
+	// Mana each character expects from each use, as of the start of the iteration.
+	expectedManaPerUse []float64
+
+	initialDelay    time.Duration
+	remainingUsages int
+}
+
+func NewManaTideTotemSchedule(characters []*Character) *ManaTideTotemSchedule {
+	return &ManaTideTotemSchedule{
+		characters:         characters,
+		expectedManaPerUse: make([]float64, len(characters)),
+	}
+}
+
+// Must be called at the start of each iteration.
+func (schedule *ManaTideTotemSchedule) Init(sim *Simulation) {
+	// Use first MTT at 60s, or halfway through the fight, whichever comes first.
+	schedule.initialDelay = MinDuration(sim.Duration/2, time.Second*60)
+	schedule.remainingUsages = int(1 + MaxDuration(0, sim.Duration-schedule.initialDelay)/ManaTideTotemCD)
+	for i, character := range schedule.characters {
+		if !character.HasManaBar() {
+			continue
+		}
+		schedule.expectedManaPerUse[i] = ManaTideTotemAmount(character)
+		character.ExpectedBonusMana += schedule.expectedManaPerUse[i] * float64(schedule.remainingUsages)
+	}
+}
+
+// Whether it's time to use Mana Tide. A normal resto shaman would wait.
+func (schedule *ManaTideTotemSchedule) ShouldActivate(sim *Simulation) bool {
+	return sim.CurrentTime >= schedule.initialDelay
+}
+
+// Gives each character the Mana Tide aura and updates their expected mana
+// for the uses remaining.
+func (schedule *ManaTideTotemSchedule) Activate(sim *Simulation, actionTag int32) {
+	newRemainingUsages := int(sim.GetRemainingDuration() / ManaTideTotemCD)
+	for i, character := range schedule.characters {
+		if !character.HasManaBar() {
+			continue
+		}
+		// AddManaTideTotemAura already accounts for 1 usage, which is why we subtract 1 less.
+		character.ExpectedBonusMana -= schedule.expectedManaPerUse[i] * MaxFloat(0, float64(schedule.remainingUsages-newRemainingUsages-1))
+		AddManaTideTotemAura(sim, character, actionTag)
+	}
+	schedule.remainingUsages = newRemainingUsages
+}
+
 func registerManaTideTotemCD(agent Agent, numManaTideTotems int32) {
-	expectedManaPerManaTideTotem := 0.0
-	remainingManaTideTotemUsages := 0
-	initialDelay := time.Duration(0)
+	schedule := NewManaTideTotemSchedule([]*Character{agent.GetCharacter()})
 
 	registerExternalConsecutiveCDApproximation(
 		agent,
@@ -754,27 +809,13 @@ func registerManaTideTotemCD(agent Agent, numManaTideTotems int32) {
 			AuraCD:           ManaTideTotemCD,
 			Type:             CooldownTypeMana,
 			Init: func(sim *Simulation, character *Character) {
-				// Use first MTT at 60s, or halfway through the fight, whichever comes first.
-				initialDelay = MinDuration(sim.Duration/2, time.Second*60)
-
-				expectedManaPerManaTideTotem = ManaTideTotemAmount(character)
-				remainingManaTideTotemUsages = int(1 + MaxDuration(0, sim.Duration-initialDelay)/ManaTideTotemCD)
-				character.ExpectedBonusMana += expectedManaPerManaTideTotem * float64(remainingManaTideTotemUsages)
+				schedule.Init(sim)
 			},
 			ShouldActivate: func(sim *Simulation, character *Character) bool {
-				// A normal resto shaman would wait to use MTT.
-				if sim.CurrentTime < initialDelay {
-					return false
-				}
-				return true
+				return schedule.ShouldActivate(sim)
 			},
 			AddAura: func(sim *Simulation, character *Character) {
-				AddManaTideTotemAura(sim, character, -1)
-
-				newRemainingUsages := int(sim.GetRemainingDuration() / ManaTideTotemCD)
-				// AddManaTideTotemAura already accounts for 1 usage, which is why we subtract 1 less.
-				character.ExpectedBonusMana -= expectedManaPerManaTideTotem * MaxFloat(0, float64(remainingManaTideTotemUsages-newRemainingUsages-1))
-				remainingManaTideTotemUsages = newRemainingUsages
+				schedule.Activate(sim, -1)
 			},
 		},
 		numManaTideTotems)
@@ -149,6 +149,10 @@ func (cast *Cast) startCasting(sim *Simulation, onCastComplete OnCastComplete) b
 		}
 	}
 
+	if cast.CastTime > 0 && cast.Character.IsMoving(sim) {
+		panic("Cannot start a hardcast while moving: " + cast.ActionID.String())
+	}
+
 	if sim.Log != nil {
 		cast.Character.Log(sim, "Casting %s (Current Mana = %0.03f, Mana Cost = %0.03f, Cast Time = %s)",
 			cast.ActionID, cast.Character.CurrentMana(), MaxFloat(0, cast.ManaCost), cast.CastTime)
@@ -159,6 +163,7 @@ func (cast *Cast) startCasting(sim *Simulation, onCastComplete OnCastComplete) b
 	if cast.GCD != 0 {
 		// Prevent any actions on the GCD until the cast AND the GCD are done.
 		gcdCD := MaxDuration(cast.CalculatedGCD(cast.Character), cast.CastTime+cast.AfterCastDelay)
+		gcdCD += cast.Character.ActionDelay(sim)
 		cast.Character.SetGCDTimer(sim, sim.CurrentTime+gcdCD)
 	}
 
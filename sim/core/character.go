@@ -58,6 +58,9 @@ type Character struct {
 	// Provides major cooldown management behavior.
 	majorCooldownManager
 
+	// Provides APL rotation behavior, for specs which support it.
+	aplRotation
+
 	// Up reference to this Character's Party.
 	Party *Party
 
@@ -95,11 +98,22 @@ type Character struct {
 	// Statistics describing the results of the sim.
 	Metrics CharacterMetrics
 
+	// Delays this character adds between actions and before reacting to procs.
+	Latency Latency
+
 	// Hack for ensuring we don't apply windfury totem aura if there's already
 	// a MH imbue.
 	// TODO: Figure out a cleaner way to do this.
 	HasMHWeaponImbue bool
 
+	// Whether this character returns mana to its party with Vampiric Touch,
+	// in which case the party ignores the estimated ShadowPriestDps buff.
+	GivesVampiricTouchMana bool
+
+	// Whether this character casts Mana Tide Totem for its party, in which
+	// case the party ignores the estimated ManaTideTotems buff.
+	GivesManaTideTotem bool
+
 	// GCD-related PendingActions for this character.
 	gcdAction      *PendingAction
 	hardcastAction *PendingAction
@@ -108,17 +122,27 @@ type Character struct {
 	waitingForMana float64
 	waitStartTime  time.Duration
 
+	// Time at which the current movement ends.
+	movingUntil time.Duration
+
+	// Index of the target this character is assigned to attack, or -1 to
+	// follow the raid's kill order.
+	assignedTarget int32
+
+	currentHealth float64
+	dead          bool
+
 	// Cached mana return values per tick.
 	manaTickWhileCasting    float64
 	manaTickWhileNotCasting float64
 }
 
-func NewCharacter(party *Party, partyIndex int, player proto.Player) Character {
+func NewCharacter(party *Party, partyIndex int, player proto.Player, itemDB *items.Database) Character {
 	character := Character{
 		Name:  player.Name,
 		Race:  player.Race,
 		Class: player.Class,
-		Equip: items.ProtoToEquipment(*player.Equipment),
+		Equip: itemDB.ProtoToEquipment(*player.Equipment),
 
 		PseudoStats: stats.NewPseudoStats(),
 
@@ -128,16 +152,24 @@ func NewCharacter(party *Party, partyIndex int, player proto.Player) Character {
 
 		auraTracker:          newAuraTracker(false),
 		majorCooldownManager: newMajorCooldownManager(player.Cooldowns),
+		aplRotation:          newAPLRotation(player.AplRotation),
 
 		Metrics: NewCharacterMetrics(),
 	}
 
 	character.Label = fmt.Sprintf("%s (#%d)", character.Name, character.RaidIndex+1)
 
+	character.assignedTarget = -1
+	if player.TargetAssignment != nil {
+		character.assignedTarget = player.TargetAssignment.TargetIndex
+	}
+
 	if player.Consumes != nil {
 		character.Consumes = *player.Consumes
 	}
 
+	character.Latency = NewLatency(player.Latency)
+
 	character.baseStats = BaseStats[BaseStatsKey{Race: character.Race, Class: character.Class}]
 	character.AddStats(character.baseStats)
 	character.AddStats(character.Equip.Stats())
@@ -406,6 +438,7 @@ func (character *Character) Finalize() {
 
 	character.auraTracker.finalize()
 	character.majorCooldownManager.finalize(character)
+	character.aplRotation.finalize(character)
 
 	for _, petAgent := range character.Pets {
 		petAgent.GetPet().Finalize()
@@ -416,6 +449,9 @@ func (character *Character) reset(sim *Simulation, agent Agent) {
 	character.stats = character.initialStats
 	character.PseudoStats = character.initialPseudoStats
 	character.ExpectedBonusMana = 0
+	character.movingUntil = 0
+	character.currentHealth = character.MaxHealth()
+	character.dead = false
 	character.UpdateManaRegenRates()
 
 	character.energyBar.reset(sim)
@@ -556,6 +592,7 @@ func (character *Character) GetStatsProto() *proto.PlayerStats {
 		FinalStats: finalStats[:],
 		Sets:       setBonusNames,
 		Cooldowns:  character.GetMajorCooldownIDs(),
+		Warnings:   character.GetUnimplementedEffectWarnings(),
 	}
 }
 
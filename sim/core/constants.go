@@ -4,6 +4,9 @@ import (
 	"time"
 )
 
+// Level of all player characters.
+const CharacterLevel = 70
+
 const GCDMin = time.Second * 1
 const GCDDefault = time.Millisecond * 1500
 
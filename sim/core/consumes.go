@@ -317,6 +317,7 @@ func registerDrumsCD(agent Agent, partyBuffs proto.PartyBuffs, consumes proto.Co
 
 	if drumsSelfCast {
 		mcd.UsesGCD = true
+		mcd.CastTime = time.Second * 1
 		mcd.ActivationFactory = func(sim *Simulation) CooldownActivation {
 			character := agent.GetCharacter()
 			drumsTemplate := SimpleCast{
@@ -0,0 +1,342 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+const defaultCooldownTimeStep = time.Second * 10
+const defaultMaxCooldownPasses = 2
+
+// A single major cooldown of a single player.
+type cooldownSlot struct {
+	raidIndex int32
+	actionID  ActionID
+
+	// Time between uses in a schedule.
+	period time.Duration
+}
+
+// Timings for each cooldownSlot, in seconds. Nil means as soon as possible.
+type cooldownSchedules [][]float64
+
+func (schedules cooldownSchedules) with(slotIdx int, timings []float64) cooldownSchedules {
+	newSchedules := make(cooldownSchedules, len(schedules))
+	copy(newSchedules, schedules)
+	newSchedules[slotIdx] = timings
+	return newSchedules
+}
+
+// Searches for fixed cooldown timings which give the highest raid DPS.
+//
+// Each cooldown is tried as soon as possible, and with uses every period
+// starting from a range of first-use times, including times which line up
+// with Bloodlust or put the last use at the start of execute phase. Cooldowns
+// are optimized one at a time, keeping the others fixed, for several passes.
+type cooldownOptimizer struct {
+	request    *proto.CooldownOptimizerRequest
+	simOptions *proto.SimOptions
+
+	slots []cooldownSlot
+
+	duration           time.Duration
+	executePhaseBegins time.Duration
+	timeStep           time.Duration
+
+	// Average raid DPS of each set of schedules which has been simmed.
+	results map[string]float64
+	numSims int32
+}
+
+func optimizeCooldowns(request *proto.CooldownOptimizerRequest) *proto.CooldownOptimizerResult {
+	result, err := runCooldownOptimizer(request)
+	if err != nil {
+		return &proto.CooldownOptimizerResult{
+			ErrorResult: err.Error(),
+		}
+	}
+	return result
+}
+
+func runCooldownOptimizer(request *proto.CooldownOptimizerRequest) (*proto.CooldownOptimizerResult, error) {
+	optimizer, err := newCooldownOptimizer(request)
+	if err != nil {
+		return nil, err
+	}
+
+	inputSchedules := optimizer.inputSchedules()
+	inputDps, err := optimizer.evaluate(inputSchedules)
+	if err != nil {
+		return nil, err
+	}
+	asapSchedules := make(cooldownSchedules, len(optimizer.slots))
+	asapDps, err := optimizer.evaluate(asapSchedules)
+	if err != nil {
+		return nil, err
+	}
+
+	bestSchedules, bestDps := inputSchedules, inputDps
+	if asapDps > bestDps {
+		bestSchedules, bestDps = asapSchedules, asapDps
+	}
+
+	maxPasses := int(request.MaxPasses)
+	if maxPasses <= 0 {
+		maxPasses = defaultMaxCooldownPasses
+	}
+	for pass := 0; pass < maxPasses; pass++ {
+		improved := false
+		for slotIdx := range optimizer.slots {
+			for _, timings := range optimizer.candidateTimings(bestSchedules, slotIdx) {
+				schedules := bestSchedules.with(slotIdx, timings)
+				dps, err := optimizer.evaluate(schedules)
+				if err != nil {
+					return nil, err
+				}
+				if dps > bestDps {
+					bestSchedules, bestDps = schedules, dps
+					improved = true
+				}
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+
+	raid := optimizer.buildRaid(bestSchedules)
+	result := &proto.CooldownOptimizerResult{
+		Raid:        raid,
+		Dps:         bestDps,
+		InputDps:    inputDps,
+		AsapDps:     asapDps,
+		DpsGain:     bestDps - inputDps,
+		AsapDpsGain: bestDps - asapDps,
+		NumSims:     optimizer.numSims,
+	}
+	for _, raidIndex := range optimizer.optimizedRaidIndices() {
+		result.Players = append(result.Players, &proto.OptimizedCooldowns{
+			RaidIndex: raidIndex,
+			Cooldowns: getRaidPlayer(raid, raidIndex).Cooldowns,
+		})
+	}
+	return result, nil
+}
+
+func newCooldownOptimizer(request *proto.CooldownOptimizerRequest) (*cooldownOptimizer, error) {
+	if request.RaidSimRequest == nil || request.RaidSimRequest.Raid == nil {
+		return nil, fmt.Errorf("cooldown optimizer request must have a raid")
+	}
+
+	optimizer := &cooldownOptimizer{
+		request:    request,
+		simOptions: optimizerSimOptions(request.RaidSimRequest.SimOptions),
+		timeStep:   DurationFromSeconds(request.TimeStep),
+		results:    make(map[string]float64),
+	}
+	if optimizer.timeStep <= 0 {
+		optimizer.timeStep = defaultCooldownTimeStep
+	}
+
+	// Build a sim to find each player's cooldowns and the encounter timings.
+	sim, err := newSim(proto.RaidSimRequest{
+		Raid:            request.RaidSimRequest.Raid,
+		Encounter:       request.RaidSimRequest.Encounter,
+		SimOptions:      optimizer.simOptions,
+		CustomItems:     request.RaidSimRequest.CustomItems,
+		PresetEncounter: request.RaidSimRequest.PresetEncounter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	optimizer.duration = sim.BaseDuration
+	optimizer.executePhaseBegins = sim.encounter.executePhaseBegins
+
+	raidIndices := map[int32]bool{}
+	for _, raidIndex := range request.RaidIndices {
+		raidIndices[raidIndex] = true
+	}
+
+	for _, party := range sim.Raid.Parties {
+		for _, player := range party.Players {
+			character := player.GetCharacter()
+			if len(raidIndices) > 0 && !raidIndices[int32(character.RaidIndex)] {
+				continue
+			}
+
+			for _, mcd := range character.initialMajorCooldowns {
+				if mcd.Type == CooldownTypeMana && !request.IncludeManaCooldowns {
+					continue
+				}
+
+				period := MaxDuration(mcd.Cooldown, mcd.SharedCooldown)
+				if period <= 0 {
+					period = optimizer.duration
+				}
+				optimizer.slots = append(optimizer.slots, cooldownSlot{
+					raidIndex: int32(character.RaidIndex),
+					actionID:  mcd.ActionID,
+					period:    period,
+				})
+			}
+		}
+	}
+
+	return optimizer, nil
+}
+
+func getRaidPlayer(raid *proto.Raid, raidIndex int32) *proto.Player {
+	partyIdx := int(raidIndex) / maxPartySize
+	playerIdx := int(raidIndex) % maxPartySize
+	if partyIdx >= len(raid.Parties) || playerIdx >= len(raid.Parties[partyIdx].GetPlayers()) {
+		panic(fmt.Sprintf("No player at raid index %d", raidIndex))
+	}
+	return raid.Parties[partyIdx].Players[playerIdx]
+}
+
+// Returns the raid indices of players with optimized cooldowns, in order.
+func (optimizer *cooldownOptimizer) optimizedRaidIndices() []int32 {
+	raidIndices := []int32{}
+	for _, slot := range optimizer.slots {
+		if len(raidIndices) == 0 || raidIndices[len(raidIndices)-1] != slot.raidIndex {
+			raidIndices = append(raidIndices, slot.raidIndex)
+		}
+	}
+	return raidIndices
+}
+
+// Returns the timings from the input request for each slot.
+func (optimizer *cooldownOptimizer) inputSchedules() cooldownSchedules {
+	schedules := make(cooldownSchedules, len(optimizer.slots))
+	for slotIdx, slot := range optimizer.slots {
+		player := getRaidPlayer(optimizer.request.RaidSimRequest.Raid, slot.raidIndex)
+		for _, cooldownConfig := range player.GetCooldowns().GetCooldowns() {
+			if cooldownConfig.Id != nil && ProtoToActionID(*cooldownConfig.Id).SameAction(slot.actionID) {
+				if len(cooldownConfig.Timings) > 0 {
+					schedules[slotIdx] = cooldownConfig.Timings
+				}
+				break
+			}
+		}
+	}
+	return schedules
+}
+
+// Returns the schedules to try for a slot, given the current best schedules.
+func (optimizer *cooldownOptimizer) candidateTimings(schedules cooldownSchedules, slotIdx int) [][]float64 {
+	slot := optimizer.slots[slotIdx]
+	maxOffset := MinDuration(slot.period, optimizer.duration)
+
+	offsets := []time.Duration{}
+	for offset := time.Duration(0); offset < maxOffset; offset += optimizer.timeStep {
+		offsets = append(offsets, offset)
+	}
+
+	// Last use at the start of execute phase.
+	if optimizer.executePhaseBegins > 0 && optimizer.executePhaseBegins < optimizer.duration {
+		offsets = append(offsets, optimizer.executePhaseBegins%slot.period)
+	}
+
+	// First use together with Bloodlust on the same party.
+	if bloodlustStart, ok := optimizer.bloodlustStart(schedules, slot.raidIndex); ok {
+		offsets = append(offsets, bloodlustStart%slot.period)
+	}
+
+	candidates := [][]float64{nil}
+	seen := map[time.Duration]bool{}
+	for _, offset := range offsets {
+		if seen[offset] {
+			continue
+		}
+		seen[offset] = true
+
+		timings := []float64{}
+		for t := offset; t < optimizer.duration; t += slot.period {
+			timings = append(timings, t.Seconds())
+		}
+		candidates = append(candidates, timings)
+	}
+	return candidates
+}
+
+// Returns when Bloodlust is first used on the player's party, according to
+// the schedules. Bloodlust used as soon as possible is assumed to start at 0.
+func (optimizer *cooldownOptimizer) bloodlustStart(schedules cooldownSchedules, raidIndex int32) (time.Duration, bool) {
+	partyIdx := raidIndex / maxPartySize
+	for slotIdx, slot := range optimizer.slots {
+		if slot.raidIndex/maxPartySize != partyIdx || slot.actionID.SpellID != SpellIDBloodlust {
+			continue
+		}
+		if len(schedules[slotIdx]) == 0 {
+			return 0, true
+		}
+		return DurationFromSeconds(schedules[slotIdx][0]), true
+	}
+	return 0, false
+}
+
+// Runs a raid sim with the given schedules and returns the average raid DPS.
+// Schedules which were already simmed are not simmed again.
+func (optimizer *cooldownOptimizer) evaluate(schedules cooldownSchedules) (float64, error) {
+	key := fmt.Sprint(schedules)
+	if dps, ok := optimizer.results[key]; ok {
+		return dps, nil
+	}
+
+	optimizer.numSims++
+	result := RunRaidSim(&proto.RaidSimRequest{
+		Raid:            optimizer.buildRaid(schedules),
+		Encounter:       optimizer.request.RaidSimRequest.Encounter,
+		SimOptions:      optimizer.simOptions,
+		CustomItems:     optimizer.request.RaidSimRequest.CustomItems,
+		PresetEncounter: optimizer.request.RaidSimRequest.PresetEncounter,
+	})
+	if result.ErrorResult != "" {
+		return 0, errors.New(result.ErrorResult)
+	}
+
+	dps := result.RaidMetrics.Dps.Avg
+	optimizer.results[key] = dps
+	return dps, nil
+}
+
+// Returns a copy of the input raid with the given schedules applied. Cooldown
+// settings for cooldowns which aren't being optimized are kept.
+func (optimizer *cooldownOptimizer) buildRaid(schedules cooldownSchedules) *proto.Raid {
+	raid := googleProto.Clone(optimizer.request.RaidSimRequest.Raid).(*proto.Raid)
+	for slotIdx, slot := range optimizer.slots {
+		player := getRaidPlayer(raid, slot.raidIndex)
+		if player.Cooldowns == nil {
+			player.Cooldowns = &proto.Cooldowns{}
+		}
+
+		cooldownConfigs := []*proto.Cooldown{}
+		for _, cooldownConfig := range player.Cooldowns.Cooldowns {
+			if cooldownConfig.Id == nil || !ProtoToActionID(*cooldownConfig.Id).SameAction(slot.actionID) {
+				cooldownConfigs = append(cooldownConfigs, cooldownConfig)
+			}
+		}
+		if schedules[slotIdx] != nil {
+			cooldownConfigs = append(cooldownConfigs, &proto.Cooldown{
+				Id:      slot.actionID.ToProto(),
+				Timings: roundTimings(schedules[slotIdx]),
+			})
+		}
+		player.Cooldowns.Cooldowns = cooldownConfigs
+	}
+	return raid
+}
+
+// Rounds timings to the nearest millisecond, so they display cleanly.
+func roundTimings(timings []float64) []float64 {
+	rounded := make([]float64, len(timings))
+	for i, timing := range timings {
+		rounded[i] = math.Round(timing*1000) / 1000
+	}
+	return rounded
+}
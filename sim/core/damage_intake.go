@@ -0,0 +1,96 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// Recurring damage dealt to players by the encounter, e.g. boss melee on the
+// tank or a raid-wide pulse. This is what gives healers something to heal.
+type DamageIntake struct {
+	// Raid indices of the players who take the damage, or empty for all players.
+	RaidIndices []int32
+
+	Damage float64
+
+	// Each hit's damage is randomly changed by up to this fraction.
+	DamageVariation float64
+
+	StartTime time.Duration
+
+	// If > 0, the damage repeats this long after each occurrence.
+	Interval time.Duration
+}
+
+func NewDamageIntake(options proto.DamageIntake) DamageIntake {
+	return DamageIntake{
+		RaidIndices:     options.RaidIndices,
+		Damage:          options.Damage,
+		DamageVariation: options.DamageVariation,
+		StartTime:       DurationFromSeconds(options.StartTime),
+		Interval:        DurationFromSeconds(options.Interval),
+	}
+}
+
+var EncounterDamageActionID = ActionID{OtherID: proto.OtherAction_OtherActionEncounterDamage}
+
+// Schedules the first occurrence of each damage intake. Should be called
+// after the raid is reset.
+func (encounter *Encounter) resetDamageIntake(sim *Simulation) {
+	for _, intake := range encounter.DamageIntake {
+		if intake.Damage <= 0 {
+			continue
+		}
+
+		targets := damageIntakeTargets(sim.Raid.AllCharacters(), intake.RaidIndices)
+		if len(targets) == 0 {
+			continue
+		}
+		encounter.scheduleDamageIntake(sim, intake, targets, intake.StartTime)
+	}
+}
+
+func (encounter *Encounter) scheduleDamageIntake(sim *Simulation, intake DamageIntake, targets []*Character, hitTime time.Duration) {
+	if hitTime > sim.Duration {
+		return
+	}
+
+	sim.AddPendingAction(&PendingAction{
+		Name:         "Damage Intake",
+		Priority:     ActionPriorityPhase,
+		NextActionAt: hitTime,
+		OnAction: func(sim *Simulation) {
+			for _, character := range targets {
+				damage := intake.Damage
+				if intake.DamageVariation > 0 {
+					damage *= 1 + (sim.RandomFloat("Damage Intake")*2-1)*intake.DamageVariation
+				}
+				character.TakeDamage(sim, damage, EncounterDamageActionID)
+			}
+
+			if intake.Interval > 0 {
+				encounter.scheduleDamageIntake(sim, intake, targets, hitTime+intake.Interval)
+			}
+		},
+	})
+}
+
+// Returns the characters with the given raid indices, or all of them if there
+// are none.
+func damageIntakeTargets(characters []*Character, raidIndices []int32) []*Character {
+	if len(raidIndices) == 0 {
+		return characters
+	}
+
+	targets := []*Character{}
+	for _, character := range characters {
+		for _, raidIndex := range raidIndices {
+			if int32(character.RaidIndex) == raidIndex {
+				targets = append(targets, character)
+				break
+			}
+		}
+	}
+	return targets
+}
@@ -32,6 +32,12 @@ func applyDebuffEffects(target *Target, debuffs proto.Debuffs) {
 		})
 	}
 
+	if debuffs.CurseOfShadow != proto.TristateEffect_TristateEffectMissing {
+		target.AddPermanentAura(func(sim *Simulation) Aura {
+			return CurseOfShadowAura(debuffs.CurseOfShadow)
+		})
+	}
+
 	if debuffs.IsbUptime > 0.0 {
 		uptime := MinFloat(1.0, debuffs.IsbUptime)
 		target.AddPermanentAuraWithOptions(PermanentAura{
@@ -199,6 +205,10 @@ func ImprovedSealOfTheCrusaderAura() Aura {
 
 var CurseOfElementsDebuffID = NewDebuffID()
 
+// Resistance reduction from Curse of the Elements and Curse of Shadow. This is
+// applied by Target.Resistance while the curse is active.
+const curseResistanceReduction = 88
+
 func CurseOfElementsAura(coe proto.TristateEffect) Aura {
 	mult := 1.1
 	level := int32(0)
@@ -229,6 +239,34 @@ func CurseOfElementsAura(coe proto.TristateEffect) Aura {
 	}
 }
 
+var CurseOfShadowDebuffID = NewDebuffID()
+
+func CurseOfShadowAura(cos proto.TristateEffect) Aura {
+	mult := 1.1
+	level := int32(0)
+	if cos == proto.TristateEffect_TristateEffectImproved {
+		mult = 1.13
+		level = 3
+	}
+	return Aura{
+		ID:       CurseOfShadowDebuffID,
+		ActionID: ActionID{SpellID: 27229},
+		Stacks:   level, // Use stacks to store talent level for detection by other code.
+		OnBeforeSpellHit: func(sim *Simulation, spellCast *SpellCast, spellEffect *SpellEffect) {
+			if spellCast.SpellSchool != stats.ArcaneSpellPower && spellCast.SpellSchool != stats.ShadowSpellPower {
+				return // does not apply to these schools
+			}
+			spellEffect.DamageMultiplier *= mult
+		},
+		OnBeforePeriodicDamage: func(sim *Simulation, spellCast *SpellCast, spellEffect *SpellEffect, tickDamage *float64) {
+			if spellCast.SpellSchool != stats.ArcaneSpellPower && spellCast.SpellSchool != stats.ShadowSpellPower {
+				return // does not apply to these schools
+			}
+			*tickDamage *= mult
+		},
+	}
+}
+
 var ImprovedShadowBoltID = NewDebuffID()
 
 func ImprovedShadowBoltAura(uptime float64) Aura {
@@ -121,8 +121,15 @@ type SimpleSpell struct {
 	// Maximum amount of pre-crit damage this spell is allowed to do.
 	AOECap float64
 
+	// Speed of this spell's missile, in yards per second, or 0 if the spell
+	// hits as soon as the cast completes.
+	MissileSpeed float64
+
 	// The action currently used for the dot effects of this spell, or nil if not ticking.
 	currentDotAction *PendingAction
+
+	// The action for this spell's missile, or nil if not in flight.
+	currentMissileAction *PendingAction
 }
 
 // Init will call any 'OnCast' effects associated with the caster and then apply spell haste to the cast.
@@ -165,112 +172,38 @@ func (spell *SimpleSpell) GetDuration() time.Duration {
 
 func (spell *SimpleSpell) Cast(sim *Simulation) bool {
 	return spell.startCasting(sim, func(sim *Simulation, cast *Cast) {
-		if len(spell.Effects) == 0 {
-			hitEffect := &spell.Effect
-			hitEffect.beforeCalculations(sim, &spell.SpellCast)
-
-			if hitEffect.Hit {
-				// Only apply direct damage if it has damage. Otherwise this is a dot without direct damage.
-				if hitEffect.DirectInput.MaxBaseDamage != 0 {
-					hitEffect.calculateDirectDamage(sim, &spell.SpellCast)
-				}
-
-				if hitEffect.DotInput.NumberOfTicks != 0 {
-					hitEffect.takeDotSnapshot(sim, &spell.SpellCast)
-
-					pa := sim.pendingActionPool.Get()
-					pa.Priority = ActionPriorityDOT
-					pa.NextActionAt = sim.CurrentTime + hitEffect.DotInput.TickLength
-					pa.OnAction = func(sim *Simulation) {
-						hitEffect.calculateDotDamage(sim, &spell.SpellCast)
-						hitEffect.afterDotTick(sim, &spell.SpellCast)
-
-						if hitEffect.DotInput.tickIndex < hitEffect.DotInput.NumberOfTicks {
-							// Refresh action.
-							pa.NextActionAt = sim.CurrentTime + hitEffect.DotInput.TickLength
-							sim.AddPendingAction(pa)
-						} else {
-							pa.CleanUp(sim)
-						}
-					}
-					pa.CleanUp = func(sim *Simulation) {
-						if pa.cancelled {
-							return
-						}
-						pa.cancelled = true
-						if spell.currentDotAction != nil {
-							spell.currentDotAction.cancelled = true
-							spell.currentDotAction = nil
-						}
-
-						hitEffect.onDotComplete(sim, &spell.SpellCast)
-
-						spell.Character.Metrics.AddSpellCast(&spell.SpellCast)
-						spell.objectInUse = false
-					}
-
-					spell.currentDotAction = pa
-					sim.AddPendingAction(pa)
-				}
-			}
-
-			hitEffect.applyResultsToCast(&spell.SpellCast)
-			hitEffect.afterCalculations(sim, &spell.SpellCast)
+		if travelTime := sim.MissileTravelTime(spell.MissileSpeed); travelTime > 0 {
+			spell.launchMissile(sim, travelTime)
 		} else {
-			// Use a separate loop for the beforeCalculations() calls so that they all
-			// come before the first afterCalculations() call. This prevents proc effects
-			// on the first hit from benefitting other hits of the same spell.
-			for effectIdx := range spell.Effects {
-				hitEffect := &spell.Effects[effectIdx]
-				hitEffect.beforeCalculations(sim, &spell.SpellCast)
-			}
+			spell.applyEffects(sim)
+		}
+	})
+}
 
-			for effectIdx := range spell.Effects {
-				hitEffect := &spell.Effects[effectIdx]
-				if hitEffect.Hit {
-					// Only apply direct damage if it has damage. Otherwise this is a dot without direct damage.
-					if hitEffect.DirectInput.MaxBaseDamage != 0 {
-						hitEffect.calculateDirectDamage(sim, &spell.SpellCast)
-					}
+func (spell *SimpleSpell) applyEffects(sim *Simulation) {
+	if len(spell.Effects) == 0 {
+		hitEffect := &spell.Effect
+		hitEffect.beforeCalculations(sim, &spell.SpellCast)
 
-					if hitEffect.DotInput.NumberOfTicks != 0 {
-						hitEffect.takeDotSnapshot(sim, &spell.SpellCast)
-					}
-				}
+		if hitEffect.Hit {
+			// Only apply direct damage if it has damage. Otherwise this is a dot without direct damage.
+			if hitEffect.DirectInput.MaxBaseDamage != 0 {
+				hitEffect.calculateDirectDamage(sim, &spell.SpellCast)
 			}
 
-			spell.applyAOECap()
+			if hitEffect.DotInput.NumberOfTicks != 0 {
+				hitEffect.takeDotSnapshot(sim, &spell.SpellCast)
 
-			// Use a separate loop for the afterCalculations() calls so all effect damage
-			// is fully calculated before invoking proc callbacks.
-			for effectIdx := range spell.Effects {
-				hitEffect := &spell.Effects[effectIdx]
-				hitEffect.applyResultsToCast(&spell.SpellCast)
-				hitEffect.afterCalculations(sim, &spell.SpellCast)
-			}
-
-			// This assumes that the effects either all have dots, or none of them do.
-			if spell.Effects[0].DotInput.NumberOfTicks != 0 {
 				pa := sim.pendingActionPool.Get()
-
 				pa.Priority = ActionPriorityDOT
-				pa.NextActionAt = sim.CurrentTime + spell.Effects[0].DotInput.TickLength
-
+				pa.NextActionAt = sim.CurrentTime + hitEffect.DotInput.TickLength
 				pa.OnAction = func(sim *Simulation) {
-					for i := range spell.Effects {
-						spell.Effects[i].calculateDotDamage(sim, &spell.SpellCast)
-					}
-
-					spell.applyAOECap()
-
-					for i := range spell.Effects {
-						spell.Effects[i].afterDotTick(sim, &spell.SpellCast)
-					}
+					hitEffect.calculateDotDamage(sim, &spell.SpellCast)
+					hitEffect.afterDotTick(sim, &spell.SpellCast)
 
-					// This assumes that all the dots have the same # of ticks and tick length.
-					if spell.Effects[0].DotInput.tickIndex < spell.Effects[0].DotInput.NumberOfTicks {
+					if hitEffect.DotInput.tickIndex < hitEffect.DotInput.NumberOfTicks {
 						// Refresh action.
-						pa.NextActionAt = sim.CurrentTime + spell.Effects[0].DotInput.TickLength
+						pa.NextActionAt = sim.CurrentTime + hitEffect.DotInput.TickLength
 						sim.AddPendingAction(pa)
 					} else {
 						pa.CleanUp(sim)
@@ -285,9 +218,8 @@ func (spell *SimpleSpell) Cast(sim *Simulation) bool {
 						spell.currentDotAction.cancelled = true
 						spell.currentDotAction = nil
 					}
-					for i := range spell.Effects {
-						spell.Effects[i].onDotComplete(sim, &spell.SpellCast)
-					}
+
+					hitEffect.onDotComplete(sim, &spell.SpellCast)
 
 					spell.Character.Metrics.AddSpellCast(&spell.SpellCast)
 					spell.objectInUse = false
@@ -298,11 +230,94 @@ func (spell *SimpleSpell) Cast(sim *Simulation) bool {
 			}
 		}
 
-		if spell.currentDotAction == nil {
-			spell.Character.Metrics.AddSpellCast(&spell.SpellCast)
-			spell.objectInUse = false
+		hitEffect.applyResultsToCast(sim, &spell.SpellCast)
+		hitEffect.afterCalculations(sim, &spell.SpellCast)
+	} else {
+		// Use a separate loop for the beforeCalculations() calls so that they all
+		// come before the first afterCalculations() call. This prevents proc effects
+		// on the first hit from benefitting other hits of the same spell.
+		for effectIdx := range spell.Effects {
+			hitEffect := &spell.Effects[effectIdx]
+			hitEffect.beforeCalculations(sim, &spell.SpellCast)
 		}
-	})
+
+		for effectIdx := range spell.Effects {
+			hitEffect := &spell.Effects[effectIdx]
+			if hitEffect.Hit {
+				// Only apply direct damage if it has damage. Otherwise this is a dot without direct damage.
+				if hitEffect.DirectInput.MaxBaseDamage != 0 {
+					hitEffect.calculateDirectDamage(sim, &spell.SpellCast)
+				}
+
+				if hitEffect.DotInput.NumberOfTicks != 0 {
+					hitEffect.takeDotSnapshot(sim, &spell.SpellCast)
+				}
+			}
+		}
+
+		spell.applyAOECap()
+
+		// Use a separate loop for the afterCalculations() calls so all effect damage
+		// is fully calculated before invoking proc callbacks.
+		for effectIdx := range spell.Effects {
+			hitEffect := &spell.Effects[effectIdx]
+			hitEffect.applyResultsToCast(sim, &spell.SpellCast)
+			hitEffect.afterCalculations(sim, &spell.SpellCast)
+		}
+
+		// This assumes that the effects either all have dots, or none of them do.
+		if spell.Effects[0].DotInput.NumberOfTicks != 0 {
+			pa := sim.pendingActionPool.Get()
+
+			pa.Priority = ActionPriorityDOT
+			pa.NextActionAt = sim.CurrentTime + spell.Effects[0].DotInput.TickLength
+
+			pa.OnAction = func(sim *Simulation) {
+				for i := range spell.Effects {
+					spell.Effects[i].calculateDotDamage(sim, &spell.SpellCast)
+				}
+
+				spell.applyAOECap()
+
+				for i := range spell.Effects {
+					spell.Effects[i].afterDotTick(sim, &spell.SpellCast)
+				}
+
+				// This assumes that all the dots have the same # of ticks and tick length.
+				if spell.Effects[0].DotInput.tickIndex < spell.Effects[0].DotInput.NumberOfTicks {
+					// Refresh action.
+					pa.NextActionAt = sim.CurrentTime + spell.Effects[0].DotInput.TickLength
+					sim.AddPendingAction(pa)
+				} else {
+					pa.CleanUp(sim)
+				}
+			}
+			pa.CleanUp = func(sim *Simulation) {
+				if pa.cancelled {
+					return
+				}
+				pa.cancelled = true
+				if spell.currentDotAction != nil {
+					spell.currentDotAction.cancelled = true
+					spell.currentDotAction = nil
+				}
+				for i := range spell.Effects {
+					spell.Effects[i].onDotComplete(sim, &spell.SpellCast)
+				}
+
+				spell.Character.Metrics.AddSpellCast(&spell.SpellCast)
+				spell.objectInUse = false
+			}
+
+			spell.currentDotAction = pa
+			sim.AddPendingAction(pa)
+		}
+	}
+
+	if spell.currentDotAction == nil {
+		spell.Character.Metrics.AddSpellCast(&spell.SpellCast)
+		spell.objectInUse = false
+	}
 }
 
 func (spell *SimpleSpell) applyAOECap() {
@@ -338,6 +353,10 @@ func (spell *SimpleSpell) Cancel(sim *Simulation) {
 		spell.currentDotAction.Cancel(sim)
 		spell.currentDotAction = nil
 	}
+	if spell.currentMissileAction != nil {
+		spell.currentMissileAction.Cancel(sim)
+		spell.currentMissileAction = nil
+	}
 }
 
 type SimpleSpellTemplate struct {
@@ -352,6 +371,19 @@ func (template *SimpleSpellTemplate) Apply(newAction *SimpleSpell) {
 	*newAction = template.template
 	newAction.Effects = template.effects
 	copy(newAction.Effects, template.template.Effects)
+
+	// AoE spells shouldn't hit targets which can't be attacked in the current
+	// encounter phase.
+	numActive := 0
+	for i := range newAction.Effects {
+		if isAoeTargetActive(newAction.Effects[i].Target) {
+			newAction.Effects[numActive] = newAction.Effects[i]
+			numActive++
+		}
+	}
+	if numActive > 0 {
+		newAction.Effects = newAction.Effects[:numActive]
+	}
 }
 
 // Takes in a cast template and returns a template, so you don't need to keep track of which things to allocate yourself.
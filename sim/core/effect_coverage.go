@@ -0,0 +1,96 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// Whether the item has a special effect (Equip / Use / Chance on hit) which
+// has not been implemented with AddItemEffect().
+func ItemEffectIsMissing(item items.Item) bool {
+	return item.HasSpecialEffect && !HasItemEffect(item.ID)
+}
+
+// Whether the item has a set bonus which has not been implemented with AddItemSet().
+func ItemSetIsMissing(item items.Item) bool {
+	return item.SetName != "" && itemSetLookup[item.ID] == nil
+}
+
+// Meta gems always have a special effect in addition to their stats.
+func GemEffectIsMissing(gem items.Gem) bool {
+	return gem.Color == proto.GemColor_GemColorMeta && !HasItemEffect(gem.ID)
+}
+
+// Returns all items, gems, and sets in the database which have special effects
+// the sim does not implement.
+func GetUnimplementedEffects(db *items.Database) []*proto.UnimplementedEffect {
+	unimplemented := []*proto.UnimplementedEffect{}
+	missingSets := map[string]struct{}{}
+
+	for _, item := range db.Items {
+		if ItemEffectIsMissing(item) {
+			unimplemented = append(unimplemented, &proto.UnimplementedEffect{
+				ItemId: item.ID,
+				Name:   item.Name,
+			})
+		}
+		if ItemSetIsMissing(item) {
+			missingSets[item.SetName] = struct{}{}
+		}
+	}
+	for _, gem := range db.Gems {
+		if GemEffectIsMissing(gem) {
+			unimplemented = append(unimplemented, &proto.UnimplementedEffect{
+				GemId: gem.ID,
+				Name:  gem.Name,
+			})
+		}
+	}
+
+	setNames := make([]string, 0, len(missingSets))
+	for setName := range missingSets {
+		setNames = append(setNames, setName)
+	}
+	sort.Strings(setNames)
+	for _, setName := range setNames {
+		unimplemented = append(unimplemented, &proto.UnimplementedEffect{
+			SetName: setName,
+			Name:    setName,
+		})
+	}
+
+	return unimplemented
+}
+
+// Returns a warning for each equipped item, gem, or set with an effect the
+// sim does not implement.
+func (character *Character) GetUnimplementedEffectWarnings() []string {
+	warnings := []string{}
+	warnedSets := map[string]struct{}{}
+
+	for _, item := range character.Equip {
+		if item.ID == 0 {
+			continue
+		}
+
+		if ItemEffectIsMissing(item) {
+			warnings = append(warnings, fmt.Sprintf("Effect of %s is not implemented.", item.Name))
+		}
+		if ItemSetIsMissing(item) {
+			if _, ok := warnedSets[item.SetName]; !ok {
+				warnedSets[item.SetName] = struct{}{}
+				warnings = append(warnings, fmt.Sprintf("Set bonuses for %s are not implemented.", item.SetName))
+			}
+		}
+		for _, gem := range item.Gems {
+			if gem.ID != 0 && GemEffectIsMissing(gem) {
+				warnings = append(warnings, fmt.Sprintf("Effect of %s is not implemented.", gem.Name))
+			}
+		}
+	}
+
+	return warnings
+}
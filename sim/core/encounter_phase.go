@@ -0,0 +1,227 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// A phase of an encounter, which lasts until the next phase begins.
+type EncounterPhase struct {
+	Name string
+
+	StartTime time.Duration
+
+	// If > 0, the phase begins once the primary target's health falls to this
+	// fraction (0-1), or at StartTime if that is set and comes first. For
+	// targets without health, health is assumed to fall linearly over the
+	// iteration, as with the execute phase.
+	StartHealthFraction float64
+
+	// Whether each target, by index, can be attacked during this phase.
+	ActiveTargets []bool
+
+	DamageTakenMultiplier float64
+
+	// Whether the raid must stop attacking and casting for this phase.
+	Downtime bool
+}
+
+func NewEncounterPhase(options proto.EncounterPhase, numTargets int) EncounterPhase {
+	phase := EncounterPhase{
+		Name:                  options.Name,
+		StartTime:             DurationFromSeconds(options.StartTime),
+		StartHealthFraction:   options.StartHealthPercent / 100,
+		ActiveTargets:         make([]bool, numTargets),
+		DamageTakenMultiplier: options.DamageTakenMultiplier,
+		Downtime:              options.Downtime,
+	}
+
+	if phase.DamageTakenMultiplier == 0 {
+		phase.DamageTakenMultiplier = 1
+	}
+
+	if len(options.ActiveTargets) == 0 {
+		for i := range phase.ActiveTargets {
+			phase.ActiveTargets[i] = true
+		}
+	} else {
+		for _, targetIndex := range options.ActiveTargets {
+			if targetIndex < 0 || int(targetIndex) >= numTargets {
+				panic(fmt.Sprintf("Invalid active target index %d in encounter phase %s", targetIndex, options.Name))
+			}
+			phase.ActiveTargets[targetIndex] = true
+		}
+	}
+
+	return phase
+}
+
+// Computes when each phase begins in the current iteration, and schedules
+// the phase transitions. Should be called after targets are reset.
+func (encounter *Encounter) resetPhases(sim *Simulation) {
+	encounter.currentPhase = -1
+	encounter.phaseStartTimes = encounter.phaseStartTimes[:0]
+	for range encounter.Phases {
+		encounter.phaseStartTimes = append(encounter.phaseStartTimes, NeverExpires)
+	}
+
+	encounter.schedulePhases(sim, 0)
+
+	if healthTarget := encounter.phaseHealthTarget(); healthTarget.HasHealth() {
+		encounter.checkHealthPhases(sim, healthTarget)
+	}
+}
+
+// The first target in the kill order, whose health decides when health-based
+// phases begin.
+func (encounter *Encounter) phaseHealthTarget() *Target {
+	return encounter.killOrder[0]
+}
+
+// Returns when the given phase would begin, ignoring the other phases, or
+// NeverExpires if it only begins once the primary target's health falls far
+// enough.
+func (encounter *Encounter) phaseStartTime(sim *Simulation, phaseIndex int) time.Duration {
+	phase := encounter.Phases[phaseIndex]
+	startTime := phase.StartTime
+	if phase.StartHealthFraction > 0 {
+		// Targets without health are assumed to lose health linearly over the
+		// iteration, as with the execute phase. Targets with health start the
+		// phase from checkHealthPhases() instead.
+		healthStartTime := NeverExpires
+		if !encounter.phaseHealthTarget().HasHealth() {
+			healthStartTime = time.Duration(float64(sim.Duration) * (1 - phase.StartHealthFraction))
+		}
+
+		if startTime == 0 {
+			startTime = healthStartTime
+		} else {
+			startTime = MinDuration(startTime, healthStartTime)
+		}
+	}
+	return startTime
+}
+
+// Computes the start times of the phases from firstPhase onwards, and
+// schedules transitions for any which begin earlier than previously computed.
+func (encounter *Encounter) schedulePhases(sim *Simulation, firstPhase int) {
+	for i := firstPhase; i < len(encounter.Phases); i++ {
+		startTime := encounter.phaseStartTime(sim, i)
+		// Phases can't begin before the previous phase.
+		if i > 0 {
+			startTime = MaxDuration(startTime, encounter.phaseStartTimes[i-1])
+		}
+		if startTime >= encounter.phaseStartTimes[i] {
+			continue
+		}
+		encounter.phaseStartTimes[i] = startTime
+
+		if startTime <= sim.CurrentTime {
+			encounter.startPhase(sim, i)
+			continue
+		}
+
+		phaseIndex := i
+		sim.AddPendingAction(&PendingAction{
+			Name:         "Encounter Phase",
+			Priority:     ActionPriorityPhase,
+			NextActionAt: startTime,
+			OnAction: func(sim *Simulation) {
+				encounter.startPhase(sim, phaseIndex)
+			},
+		})
+	}
+}
+
+// Begins any upcoming phases whose health threshold the given target's health
+// has fallen to. Should be called whenever a target's health changes.
+func (encounter *Encounter) checkHealthPhases(sim *Simulation, target *Target) {
+	if len(encounter.Phases) == 0 || target != encounter.phaseHealthTarget() {
+		return
+	}
+
+	for nextPhase := encounter.currentPhase + 1; nextPhase < len(encounter.Phases); nextPhase++ {
+		phase := encounter.Phases[nextPhase]
+		if phase.StartHealthFraction == 0 || target.CurrentHealthPercent() > phase.StartHealthFraction {
+			return
+		}
+
+		encounter.phaseStartTimes[nextPhase] = sim.CurrentTime
+		encounter.startPhase(sim, nextPhase)
+		encounter.schedulePhases(sim, nextPhase+1)
+	}
+}
+
+func (encounter *Encounter) startPhase(sim *Simulation, phaseIndex int) {
+	// Phases can be started early by health thresholds, in which case their
+	// scheduled transitions are ignored.
+	if phaseIndex <= encounter.currentPhase {
+		return
+	}
+
+	phase := encounter.Phases[phaseIndex]
+	encounter.currentPhase = phaseIndex
+
+	if sim.Log != nil {
+		sim.Log("Encounter phase %d (%s) begins.", phaseIndex+1, phase.Name)
+	}
+
+	for i, target := range encounter.Targets {
+		target.active = phase.ActiveTargets[i] && !target.dead
+		if target.active {
+			target.DamageTakenMultiplier = phase.DamageTakenMultiplier
+		} else {
+			target.DamageTakenMultiplier = 0
+		}
+	}
+}
+
+// Returns the current encounter phase, or nil if the encounter has no phases
+// or the first phase hasn't begun yet.
+func (encounter *Encounter) CurrentPhase() *EncounterPhase {
+	if encounter.currentPhase < 0 {
+		return nil
+	}
+	return &encounter.Phases[encounter.currentPhase]
+}
+
+// Returns the time at which the current phase ends, or the end of the
+// iteration if this is the last phase.
+func (encounter *Encounter) currentPhaseEndsAt(sim *Simulation) time.Duration {
+	if encounter.currentPhase+1 < len(encounter.phaseStartTimes) {
+		return encounter.phaseStartTimes[encounter.currentPhase+1]
+	}
+	return sim.Duration
+}
+
+func (encounter *Encounter) hasActiveTarget() bool {
+	for _, target := range encounter.Targets {
+		if target.active {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether an AoE effect on this target should be applied. Effects whose target
+// hasn't been chosen yet are always kept.
+func isAoeTargetActive(target *Target) bool {
+	return target == nil || target.active
+}
+
+// Whether the raid can't attack or cast right now, either because of a
+// downtime phase or because there are no active targets.
+func (sim *Simulation) IsDowntime() bool {
+	if phase := sim.encounter.CurrentPhase(); phase != nil && phase.Downtime {
+		return true
+	}
+	return !sim.encounter.hasActiveTarget()
+}
+
+// Returns the time at which the current downtime ends. Only valid when
+// IsDowntime() is true.
+func (sim *Simulation) DowntimeEndsAt() time.Duration {
+	return sim.encounter.currentPhaseEndsAt(sim)
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+func TestEncounterPhases(t *testing.T) {
+	encounter := NewEncounter(proto.Encounter{
+		Duration: 100,
+		Targets:  []*proto.Target{{}, {}},
+		Phases: []*proto.EncounterPhase{
+			{Name: "Boss", ActiveTargets: []int32{0}},
+			{Name: "Adds", StartTime: 30, ActiveTargets: []int32{1}, DamageTakenMultiplier: 1.5},
+			{Name: "Airborne", StartTime: 80, StartHealthPercent: 40, Downtime: true},
+		},
+	})
+	sim := &Simulation{
+		encounter: encounter,
+		Duration:  time.Second * 100,
+	}
+
+	sim.encounter.resetPhases(sim)
+
+	expectedStartTimes := []time.Duration{0, time.Second * 30, time.Second * 60}
+	for i, startTime := range sim.encounter.phaseStartTimes {
+		if startTime != expectedStartTimes[i] {
+			t.Fatalf("Phase %d: expected start time %s, got %s", i+1, expectedStartTimes[i], startTime)
+		}
+	}
+
+	// First phase begins immediately.
+	if sim.IsDowntime() || sim.GetPrimaryTarget() != sim.GetTarget(0) || sim.GetTarget(1).IsActive() {
+		t.Fatalf("Expected only the first target to be active in the first phase")
+	}
+	if len(sim.pendingActions) != 2 {
+		t.Fatalf("Expected 2 pending phase transitions, got %d", len(sim.pendingActions))
+	}
+
+	sim.encounter.startPhase(sim, 1)
+	if sim.GetPrimaryTarget() != sim.GetTarget(1) {
+		t.Fatalf("Expected the add to be the primary target")
+	}
+	if sim.GetTarget(0).DamageTakenMultiplier != 0 || sim.GetTarget(1).DamageTakenMultiplier != 1.5 {
+		t.Fatalf("Wrong damage taken multipliers: %0.2f, %0.2f", sim.GetTarget(0).DamageTakenMultiplier, sim.GetTarget(1).DamageTakenMultiplier)
+	}
+
+	sim.encounter.startPhase(sim, 2)
+	if !sim.IsDowntime() || sim.DowntimeEndsAt() != sim.Duration {
+		t.Fatalf("Expected downtime until the end of the fight")
+	}
+}
+
+func TestEncounterPhasesFromTargetHealth(t *testing.T) {
+	encounter := NewEncounter(proto.Encounter{
+		Duration: 100,
+		Targets:  []*proto.Target{{Health: 1000}},
+		Phases: []*proto.EncounterPhase{
+			{Name: "Ground"},
+			{Name: "Airborne", StartHealthPercent: 60, Downtime: true},
+			{Name: "Enrage", StartTime: 90},
+		},
+	})
+	sim := &Simulation{
+		encounter: encounter,
+		Duration:  time.Second * 100,
+	}
+	target := sim.GetTarget(0)
+	target.Reset(sim)
+	sim.encounter.resetPhases(sim)
+
+	if sim.encounter.phaseStartTimes[1] != NeverExpires || sim.encounter.phaseStartTimes[2] != NeverExpires {
+		t.Fatalf("Expected health-based phases to wait for the target's health, got start times %v", sim.encounter.phaseStartTimes)
+	}
+
+	sim.CurrentTime = time.Second * 10
+	target.takeDamage(sim, &Character{}, ActionID{SpellID: 1}, stats.SpellPower, 300)
+	if sim.encounter.currentPhase != 0 {
+		t.Fatalf("Expected the first phase at 70%% health, got phase %d", sim.encounter.currentPhase+1)
+	}
+
+	target.takeDamage(sim, &Character{}, ActionID{SpellID: 1}, stats.SpellPower, 200)
+	if sim.encounter.currentPhase != 1 || !sim.IsDowntime() {
+		t.Fatalf("Expected the airborne phase at 50%% health, got phase %d", sim.encounter.currentPhase+1)
+	}
+	if sim.DowntimeEndsAt() != time.Second*90 {
+		t.Fatalf("Expected the enrage phase to be scheduled at 90s, got %s", sim.DowntimeEndsAt())
+	}
+}
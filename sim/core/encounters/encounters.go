@@ -0,0 +1,161 @@
+// Package encounters holds preset TBC raid boss encounters, so sim requests
+// can refer to a boss by name instead of entering its targets by hand.
+package encounters
+
+import (
+	"fmt"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Level and armor of raid bosses and of the adds which accompany them.
+const (
+	bossLevel = 73
+	addLevel  = 72
+
+	bossArmor       = 7700
+	casterBossArmor = 6200
+	addArmor        = 6200
+)
+
+type Preset struct {
+	// Unique name of the encounter, usually the boss name.
+	Name string
+	Raid string
+
+	Encounter *proto.Encounter
+}
+
+func (preset Preset) ToProto() *proto.PresetEncounter {
+	return &proto.PresetEncounter{
+		Name:      preset.Name,
+		Raid:      preset.Raid,
+		Encounter: googleProto.Clone(preset.Encounter).(*proto.Encounter),
+	}
+}
+
+var presets []Preset
+var presetsByName = map[string]Preset{}
+
+func init() {
+	registerPresets("Karazhan", karazhanPresets...)
+	registerPresets("Gruul's Lair", gruulsLairPresets...)
+	registerPresets("Magtheridon's Lair", magtheridonsLairPresets...)
+	registerPresets("Serpentshrine Cavern", serpentshrinePresets...)
+	registerPresets("Tempest Keep", tempestKeepPresets...)
+	registerPresets("Hyjal Summit", hyjalPresets...)
+	registerPresets("Black Temple", blackTemplePresets...)
+	registerPresets("Sunwell Plateau", sunwellPresets...)
+}
+
+func registerPresets(raid string, raidPresets ...Preset) {
+	for _, preset := range raidPresets {
+		preset.Raid = raid
+		if _, ok := presetsByName[preset.Name]; ok {
+			panic("Duplicate preset encounter: " + preset.Name)
+		}
+		presets = append(presets, preset)
+		presetsByName[preset.Name] = preset
+	}
+}
+
+// Returns all preset encounters, in raid order.
+func GetPresets() []Preset {
+	return presets
+}
+
+// Returns a copy of the encounter for the preset with the given name.
+func Get(name string) (*proto.Encounter, error) {
+	preset, ok := presetsByName[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown preset encounter: %s", name)
+	}
+	return googleProto.Clone(preset.Encounter).(*proto.Encounter), nil
+}
+
+// Returns the preset encounter with the given name, keeping the target
+// debuffs from base. Each preset target takes the debuffs of the base target
+// with the same index, or of the first base target if there is none.
+func Expand(name string, base *proto.Encounter) (*proto.Encounter, error) {
+	encounter, err := Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil || len(base.Targets) == 0 {
+		return encounter, nil
+	}
+
+	for i, target := range encounter.Targets {
+		baseTarget := base.Targets[0]
+		if i < len(base.Targets) {
+			baseTarget = base.Targets[i]
+		}
+		if baseTarget.Debuffs != nil {
+			target.Debuffs = googleProto.Clone(baseTarget.Debuffs).(*proto.Debuffs)
+		}
+	}
+	return encounter, nil
+}
+
+// Handles the EncounterList API.
+func GetEncounterList(request *proto.EncounterListRequest) *proto.EncounterListResult {
+	result := &proto.EncounterListResult{}
+	for _, preset := range presets {
+		if request.Name == "" || request.Name == preset.Name {
+			result.Encounters = append(result.Encounters, preset.ToProto())
+		}
+	}
+	return result
+}
+
+func boss(mobType proto.MobType) *proto.Target {
+	return &proto.Target{
+		Level:   bossLevel,
+		Armor:   bossArmor,
+		MobType: mobType,
+	}
+}
+
+func casterBoss(mobType proto.MobType) *proto.Target {
+	return &proto.Target{
+		Level:   bossLevel,
+		Armor:   casterBossArmor,
+		MobType: mobType,
+	}
+}
+
+func add(mobType proto.MobType) *proto.Target {
+	return &proto.Target{
+		Level:   addLevel,
+		Armor:   addArmor,
+		MobType: mobType,
+	}
+}
+
+// A single boss with no phases.
+func singleTarget(name string, duration float64, target *proto.Target) Preset {
+	return Preset{
+		Name: name,
+		Encounter: &proto.Encounter{
+			Duration:          duration,
+			DurationVariation: 5,
+			ExecuteProportion: 0.2,
+			Targets:           []*proto.Target{target},
+		},
+	}
+}
+
+// An encounter with several targets and a phase timeline.
+func phased(name string, duration float64, targets []*proto.Target, phases ...*proto.EncounterPhase) Preset {
+	return Preset{
+		Name: name,
+		Encounter: &proto.Encounter{
+			Duration:          duration,
+			DurationVariation: 5,
+			ExecuteProportion: 0.2,
+			Targets:           targets,
+			Phases:            phases,
+		},
+	}
+}
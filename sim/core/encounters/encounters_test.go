@@ -0,0 +1,52 @@
+package encounters
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestPresetsAreValid(t *testing.T) {
+	if len(GetPresets()) == 0 {
+		t.Fatalf("No preset encounters registered")
+	}
+
+	for _, preset := range GetPresets() {
+		encounter := preset.Encounter
+		if preset.Raid == "" || encounter.Duration <= 0 || len(encounter.Targets) == 0 {
+			t.Errorf("Preset %s is incomplete", preset.Name)
+		}
+		for _, phase := range encounter.Phases {
+			for _, targetIndex := range phase.ActiveTargets {
+				if targetIndex < 0 || int(targetIndex) >= len(encounter.Targets) {
+					t.Errorf("Preset %s phase %s has invalid target index %d", preset.Name, phase.Name, targetIndex)
+				}
+			}
+		}
+	}
+}
+
+func TestExpandKeepsDebuffs(t *testing.T) {
+	base := &proto.Encounter{
+		Targets: []*proto.Target{{Debuffs: &proto.Debuffs{JudgementOfWisdom: true}}},
+	}
+
+	encounter, err := Expand("Kael'thas Sunstrider", base)
+	if err != nil {
+		t.Fatalf("Failed to expand preset: %s", err)
+	}
+	for i, target := range encounter.Targets {
+		if target.Debuffs == nil || !target.Debuffs.JudgementOfWisdom {
+			t.Fatalf("Target %d did not keep the base debuffs", i)
+		}
+	}
+
+	// The registered preset should not be modified.
+	if presetsByName["Kael'thas Sunstrider"].Encounter.Targets[0].Debuffs != nil {
+		t.Fatalf("Expanding a preset modified the registry")
+	}
+
+	if _, err := Expand("Unknown Boss", base); err == nil {
+		t.Fatalf("Expected error for unknown preset")
+	}
+}
@@ -0,0 +1,48 @@
+package encounters
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+var karazhanPresets = []Preset{
+	singleTarget("Attumen the Huntsman", 120, boss(proto.MobType_MobTypeUndead)),
+	singleTarget("Moroes", 150, boss(proto.MobType_MobTypeUndead)),
+	singleTarget("Maiden of Virtue", 120, boss(proto.MobType_MobTypeHumanoid)),
+	singleTarget("The Big Bad Wolf", 90, boss(proto.MobType_MobTypeBeast)),
+	singleTarget("The Curator", 180, boss(proto.MobType_MobTypeMechanical)),
+	singleTarget("Terestian Illhoof", 150, casterBoss(proto.MobType_MobTypeDemon)),
+	singleTarget("Shade of Aran", 180, casterBoss(proto.MobType_MobTypeHumanoid)),
+	singleTarget("Netherspite", 240, boss(proto.MobType_MobTypeDemon)),
+	singleTarget("Prince Malchezaar", 240, boss(proto.MobType_MobTypeDemon)),
+	phased("Nightbane", 240,
+		[]*proto.Target{boss(proto.MobType_MobTypeDragonkin), add(proto.MobType_MobTypeUndead)},
+		&proto.EncounterPhase{Name: "Ground", ActiveTargets: []int32{0}},
+		// Nightbane takes off at 75% health, and skeletons are summoned while he's airborne.
+		&proto.EncounterPhase{Name: "Air", StartHealthPercent: 75, ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Ground", StartHealthPercent: 70, ActiveTargets: []int32{0}},
+	),
+}
+
+var gruulsLairPresets = []Preset{
+	phased("High King Maulgar", 210,
+		[]*proto.Target{
+			boss(proto.MobType_MobTypeGiant),
+			add(proto.MobType_MobTypeGiant),
+			add(proto.MobType_MobTypeGiant),
+			add(proto.MobType_MobTypeGiant),
+			add(proto.MobType_MobTypeGiant),
+		},
+		// Maulgar's council is usually killed before Maulgar himself.
+		&proto.EncounterPhase{Name: "Council", ActiveTargets: []int32{1, 2, 3, 4}},
+		&proto.EncounterPhase{Name: "Maulgar", StartTime: 120, ActiveTargets: []int32{0}},
+	),
+	singleTarget("Gruul the Dragonkiller", 240, boss(proto.MobType_MobTypeGiant)),
+}
+
+var magtheridonsLairPresets = []Preset{
+	phased("Magtheridon", 300,
+		[]*proto.Target{boss(proto.MobType_MobTypeDemon), add(proto.MobType_MobTypeHumanoid)},
+		&proto.EncounterPhase{Name: "Channelers", ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Magtheridon", StartTime: 60, ActiveTargets: []int32{0}},
+	),
+}
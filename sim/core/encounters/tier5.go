@@ -0,0 +1,83 @@
+package encounters
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+var serpentshrinePresets = []Preset{
+	phased("Hydross the Unstable", 300,
+		[]*proto.Target{boss(proto.MobType_MobTypeElemental), add(proto.MobType_MobTypeElemental)},
+		&proto.EncounterPhase{Name: "Boss", ActiveTargets: []int32{0}},
+		// Adds spawn each time Hydross changes form, and are killed before continuing.
+		&proto.EncounterPhase{Name: "Adds", StartTime: 60, ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Boss", StartTime: 75, ActiveTargets: []int32{0}},
+		&proto.EncounterPhase{Name: "Adds", StartTime: 135, ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Boss", StartTime: 150, ActiveTargets: []int32{0}},
+	),
+	phased("The Lurker Below", 240,
+		[]*proto.Target{boss(proto.MobType_MobTypeBeast), add(proto.MobType_MobTypeHumanoid)},
+		&proto.EncounterPhase{Name: "Boss", ActiveTargets: []int32{0}},
+		&proto.EncounterPhase{Name: "Submerged", StartTime: 90, ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Boss", StartTime: 150, ActiveTargets: []int32{0}},
+	),
+	phased("Leotheras the Blind", 300,
+		[]*proto.Target{boss(proto.MobType_MobTypeDemon)},
+		&proto.EncounterPhase{Name: "Humanoid"},
+		// Inner demons interrupt the raid's damage during the demon phases.
+		&proto.EncounterPhase{Name: "Demon", StartTime: 60, DamageTakenMultiplier: 0.8},
+		&proto.EncounterPhase{Name: "Humanoid", StartTime: 120},
+		&proto.EncounterPhase{Name: "Demon", StartTime: 180, DamageTakenMultiplier: 0.8},
+		&proto.EncounterPhase{Name: "Humanoid", StartTime: 240},
+	),
+	phased("Fathom-Lord Karathress", 240,
+		[]*proto.Target{
+			boss(proto.MobType_MobTypeHumanoid),
+			add(proto.MobType_MobTypeHumanoid),
+			add(proto.MobType_MobTypeHumanoid),
+			add(proto.MobType_MobTypeHumanoid),
+		},
+		&proto.EncounterPhase{Name: "Advisors", ActiveTargets: []int32{1, 2, 3}},
+		&proto.EncounterPhase{Name: "Karathress", StartTime: 150, ActiveTargets: []int32{0}},
+	),
+	singleTarget("Morogrim Tidewalker", 240, boss(proto.MobType_MobTypeHumanoid)),
+	phased("Lady Vashj", 420,
+		[]*proto.Target{boss(proto.MobType_MobTypeHumanoid), add(proto.MobType_MobTypeHumanoid)},
+		&proto.EncounterPhase{Name: "Phase 1", ActiveTargets: []int32{0}},
+		// Vashj is shielded during phase 2, while the raid kills adds and overloads the generators.
+		&proto.EncounterPhase{Name: "Phase 2", StartHealthPercent: 70, ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Phase 3", StartTime: 300, ActiveTargets: []int32{0}},
+	),
+}
+
+var tempestKeepPresets = []Preset{
+	phased("Al'ar", 360,
+		[]*proto.Target{boss(proto.MobType_MobTypeBeast), add(proto.MobType_MobTypeElemental)},
+		&proto.EncounterPhase{Name: "Phase 1", ActiveTargets: []int32{0}},
+		// Al'ar rebirths after phase 1, with a short window where nothing can be attacked.
+		&proto.EncounterPhase{Name: "Rebirth", StartHealthPercent: 60, Downtime: true},
+		&proto.EncounterPhase{Name: "Phase 2", StartHealthPercent: 55, ActiveTargets: []int32{0, 1}},
+	),
+	singleTarget("Void Reaver", 240, boss(proto.MobType_MobTypeMechanical)),
+	phased("High Astromancer Solarian", 240,
+		[]*proto.Target{casterBoss(proto.MobType_MobTypeHumanoid), add(proto.MobType_MobTypeHumanoid)},
+		&proto.EncounterPhase{Name: "Phase 1", ActiveTargets: []int32{0}},
+		&proto.EncounterPhase{Name: "Agents", StartTime: 50, ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Phase 1", StartTime: 70, ActiveTargets: []int32{0}},
+		&proto.EncounterPhase{Name: "Agents", StartTime: 120, ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Phase 1", StartTime: 140, ActiveTargets: []int32{0}},
+	),
+	phased("Kael'thas Sunstrider", 600,
+		[]*proto.Target{
+			casterBoss(proto.MobType_MobTypeHumanoid),
+			add(proto.MobType_MobTypeHumanoid),
+			add(proto.MobType_MobTypeMechanical),
+		},
+		&proto.EncounterPhase{Name: "Advisors", ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Weapons", StartTime: 120, ActiveTargets: []int32{2}},
+		&proto.EncounterPhase{Name: "Advisors", StartTime: 210, ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Kael'thas", StartTime: 300, ActiveTargets: []int32{0}},
+		// Kael'thas is untargetable while he drains the crystals at 50% health.
+		&proto.EncounterPhase{Name: "Gravity Lapse", StartTime: 450, Downtime: true},
+		&proto.EncounterPhase{Name: "Phase 5", StartTime: 480, ActiveTargets: []int32{0}},
+	),
+}
@@ -0,0 +1,86 @@
+package encounters
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+var hyjalPresets = []Preset{
+	singleTarget("Rage Winterchill", 180, boss(proto.MobType_MobTypeUndead)),
+	singleTarget("Anetheron", 180, boss(proto.MobType_MobTypeUndead)),
+	singleTarget("Kaz'rogal", 210, boss(proto.MobType_MobTypeDemon)),
+	singleTarget("Azgalor", 240, boss(proto.MobType_MobTypeDemon)),
+	singleTarget("Archimonde", 360, boss(proto.MobType_MobTypeDemon)),
+}
+
+var blackTemplePresets = []Preset{
+	singleTarget("High Warlord Naj'entus", 180, boss(proto.MobType_MobTypeHumanoid)),
+	singleTarget("Supremus", 240, boss(proto.MobType_MobTypeDemon)),
+	phased("Shade of Akama", 240,
+		[]*proto.Target{boss(proto.MobType_MobTypeUndead), add(proto.MobType_MobTypeHumanoid)},
+		&proto.EncounterPhase{Name: "Channelers", ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Shade", StartTime: 150, ActiveTargets: []int32{0}},
+	),
+	singleTarget("Teron Gorefiend", 240, boss(proto.MobType_MobTypeUndead)),
+	singleTarget("Gurtogg Bloodboil", 300, boss(proto.MobType_MobTypeDemon)),
+	phased("Reliquary of Souls", 360,
+		[]*proto.Target{casterBoss(proto.MobType_MobTypeUndead)},
+		&proto.EncounterPhase{Name: "Essence of Suffering"},
+		&proto.EncounterPhase{Name: "Transition", StartHealthPercent: 66, Downtime: true},
+		// Essence of Desire reflects a portion of damage, so the raid holds back.
+		&proto.EncounterPhase{Name: "Essence of Desire", StartTime: 140, DamageTakenMultiplier: 0.9},
+		&proto.EncounterPhase{Name: "Transition", StartHealthPercent: 33, Downtime: true},
+		&proto.EncounterPhase{Name: "Essence of Anger", StartTime: 260},
+	),
+	singleTarget("Mother Shahraz", 240, boss(proto.MobType_MobTypeDemon)),
+	phased("The Illidari Council", 300,
+		[]*proto.Target{
+			boss(proto.MobType_MobTypeHumanoid),
+			casterBoss(proto.MobType_MobTypeHumanoid),
+			casterBoss(proto.MobType_MobTypeHumanoid),
+			boss(proto.MobType_MobTypeHumanoid),
+		},
+	),
+	phased("Illidan Stormrage", 600,
+		[]*proto.Target{boss(proto.MobType_MobTypeDemon), add(proto.MobType_MobTypeDemon)},
+		&proto.EncounterPhase{Name: "Phase 1", ActiveTargets: []int32{0}},
+		// Illidan is airborne at 65% health while the raid kills the Flames of Azzinoth.
+		&proto.EncounterPhase{Name: "Flames of Azzinoth", StartHealthPercent: 65, ActiveTargets: []int32{1}},
+		&proto.EncounterPhase{Name: "Phase 3", StartTime: 300, ActiveTargets: []int32{0}},
+		&proto.EncounterPhase{Name: "Demon Form", StartTime: 380, ActiveTargets: []int32{0}},
+		&proto.EncounterPhase{Name: "Phase 5", StartHealthPercent: 30, ActiveTargets: []int32{0}},
+	),
+}
+
+var sunwellPresets = []Preset{
+	singleTarget("Kalecgos", 300, boss(proto.MobType_MobTypeDragonkin)),
+	singleTarget("Brutallus", 360, boss(proto.MobType_MobTypeGiant)),
+	phased("Felmyst", 360,
+		[]*proto.Target{boss(proto.MobType_MobTypeDragonkin)},
+		&proto.EncounterPhase{Name: "Ground"},
+		&proto.EncounterPhase{Name: "Air", StartTime: 60, Downtime: true},
+		&proto.EncounterPhase{Name: "Ground", StartTime: 160},
+		&proto.EncounterPhase{Name: "Air", StartTime: 220, Downtime: true},
+		&proto.EncounterPhase{Name: "Ground", StartTime: 320},
+	),
+	phased("The Eredar Twins", 360,
+		[]*proto.Target{casterBoss(proto.MobType_MobTypeDemon), casterBoss(proto.MobType_MobTypeDemon)},
+		&proto.EncounterPhase{Name: "Lady Sacrolash", ActiveTargets: []int32{0}},
+		&proto.EncounterPhase{Name: "Grand Warlock Alythess", StartTime: 200, ActiveTargets: []int32{1}},
+	),
+	phased("M'uru", 480,
+		[]*proto.Target{boss(proto.MobType_MobTypeHumanoid), add(proto.MobType_MobTypeHumanoid)},
+		&proto.EncounterPhase{Name: "M'uru", ActiveTargets: []int32{0, 1}},
+		&proto.EncounterPhase{Name: "Entropius", StartTime: 360, ActiveTargets: []int32{0}},
+	),
+	phased("Kil'jaeden", 540,
+		[]*proto.Target{boss(proto.MobType_MobTypeDemon)},
+		&proto.EncounterPhase{Name: "Phase 2"},
+		// Kil'jaeden's transitions at 85%, 55% and 25% health pause the raid while the dragons' orbs are used.
+		&proto.EncounterPhase{Name: "Transition", StartHealthPercent: 85, Downtime: true},
+		&proto.EncounterPhase{Name: "Phase 3", StartTime: 110},
+		&proto.EncounterPhase{Name: "Transition", StartHealthPercent: 55, Downtime: true},
+		&proto.EncounterPhase{Name: "Phase 4", StartTime: 260},
+		&proto.EncounterPhase{Name: "Transition", StartHealthPercent: 25, Downtime: true},
+		&proto.EncounterPhase{Name: "Phase 5", StartTime: 430},
+	),
+}
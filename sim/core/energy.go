@@ -80,8 +80,15 @@ func (eb *energyBar) reset(sim *Simulation) {
 		NextActionAt: tickDuration,
 	}
 	pa.OnAction = func(sim *Simulation) {
+		// Dead characters stay dead for the rest of the iteration.
+		if eb.character.IsDead() {
+			return
+		}
+
 		eb.AddEnergy(sim, energyPerTick, ActionID{OtherID: proto.OtherAction_OtherActionEnergyRegen})
-		eb.onEnergyTick(sim)
+		if !sim.IsDowntime() && !eb.character.IsMoving(sim) {
+			eb.onEnergyTick(sim)
+		}
 
 		pa.NextActionAt = sim.CurrentTime + tickDuration
 		sim.AddPendingAction(pa)
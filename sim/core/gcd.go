@@ -9,9 +9,21 @@ func (character *Character) newGCDAction(sim *Simulation, agent Agent) *PendingA
 	pa.Priority = ActionPriorityGCD
 	pa.OnAction = func(sim *Simulation) {
 		character := agent.GetCharacter()
+		if character.dead {
+			return
+		}
+		if sim.IsDowntime() {
+			character.WaitUntil(sim, sim.DowntimeEndsAt())
+			return
+		}
+
 		character.TryUseCooldowns(sim)
 		if !character.IsOnCD(GCDCooldownID, sim.CurrentTime) {
-			agent.OnGCDReady(sim)
+			if character.IsMoving(sim) {
+				character.onGCDReadyWhileMoving(sim, agent)
+			} else {
+				agent.OnGCDReady(sim)
+			}
 		}
 	}
 	return pa
@@ -75,7 +87,7 @@ func (character *Character) DoneWaitingForMana(sim *Simulation) bool {
 // Returns true if the character was waiting for mana but is now finished AND
 // the GCD is also ready.
 func (character *Character) FinishedWaitingForManaAndGCDReady(sim *Simulation) bool {
-	if !character.IsWaitingForMana() || !character.DoneWaitingForMana(sim) {
+	if sim.IsDowntime() || character.IsMoving(sim) || !character.IsWaitingForMana() || !character.DoneWaitingForMana(sim) {
 		return false
 	}
 
@@ -106,6 +118,7 @@ func (character *Character) HardcastWaitUntil(sim *Simulation, readyTime time.Du
 func (character *Character) WaitForMana(sim *Simulation, desiredMana float64) {
 	character.waitStartTime = sim.CurrentTime
 	character.waitingForMana = desiredMana
+	character.Metrics.markOOMStart(sim.CurrentTime)
 	if sim.Log != nil {
 		character.Log(sim, "Not enough mana to cast, pausing GCD until mana >= %0.01f.", desiredMana)
 	}
@@ -0,0 +1,391 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+// Heals crit for 150%, and aren't affected by crit damage meta gems.
+const HealCritMultiplier = 1.5
+
+// Callback for after a heal lands on its target, after healing is applied.
+// Also invoked for each tick of a heal over time.
+type OnHeal func(sim *Simulation, spellCast *SpellCast, healEffect *HealEffect)
+
+// Inputs for a heal which restores health once, when casting is complete,
+// e.g. Healing Wave or the initial heal of Regrowth.
+type DirectHealInput struct {
+	MinBaseHealing float64
+	MaxBaseHealing float64
+
+	// Increase in healing per point of healing power.
+	SpellCoefficient float64
+
+	// Adds a fixed amount of healing to the spell, before multipliers.
+	FlatHealingBonus float64
+}
+
+// Inputs for a heal over time, e.g. Renew or Rejuvenation. For now the only
+// way for a caster to track their hot is to keep a reference to the heal
+// object that started it and check HotInput.IsTicking().
+type HotInput struct {
+	NumberOfTicks        int           // number of ticks over the whole duration
+	TickLength           time.Duration // time between each tick
+	TickBaseHealing      float64
+	TickSpellCoefficient float64
+
+	// Invoked after the final tick. Not invoked if the hot is cancelled early,
+	// e.g. when it's refreshed.
+	OnExpire OnHeal
+
+	// Internal fields
+	startTime      time.Duration
+	finalTickTime  time.Duration
+	healingPerTick float64
+	tickIndex      int
+}
+
+// HealingPerTick returns the cached healing per tick of the hot.
+func (hot HotInput) HealingPerTick() float64 {
+	return hot.healingPerTick
+}
+
+func (hot HotInput) FullDuration() time.Duration {
+	return hot.TickLength * time.Duration(hot.NumberOfTicks)
+}
+
+func (hot HotInput) TimeRemaining(sim *Simulation) time.Duration {
+	return MaxDuration(0, hot.finalTickTime-sim.CurrentTime)
+}
+
+// Returns the remaining number of times this hot is expected to tick.
+func (hot HotInput) RemainingTicks() int {
+	return hot.NumberOfTicks - hot.tickIndex
+}
+
+func (hot HotInput) IsTicking(sim *Simulation) bool {
+	return hot.finalTickTime != 0 && hot.tickIndex < hot.NumberOfTicks
+}
+
+type HealEffect struct {
+	// Target of the heal. If nil, the most injured of the heal's smart targets
+	// is chosen when the heal lands, and the effect is skipped if nobody is
+	// injured.
+	Target *Character
+
+	// Bonus stats to be added to the heal.
+	BonusHealingPower float64
+	BonusCritRating   float64
+
+	// Multiplier for all healing done by this effect.
+	HealingMultiplier float64
+
+	// Skips the crit check, i.e. this effect will never crit. Hot ticks never
+	// crit regardless.
+	IgnoreCritCheck bool
+
+	// Callbacks for providing additional custom behavior.
+	OnHeal OnHeal
+
+	DirectInput DirectHealInput
+	HotInput    HotInput
+
+	// Results
+
+	Crit bool // Whether the last heal from this effect was a critical strike.
+
+	Healing     float64 // Health restored by the last heal from this effect.
+	Overhealing float64 // Healing from the last heal beyond the target's max health.
+}
+
+// Calculates the healing of the direct part of this effect, before it's
+// applied to the target.
+func (healEffect *HealEffect) calculateDirectHealing(sim *Simulation, spellCast *SpellCast) float64 {
+	baseHealing := healEffect.DirectInput.MinBaseHealing + sim.RandomFloat("DirectHeal Base Healing")*(healEffect.DirectInput.MaxBaseHealing-healEffect.DirectInput.MinBaseHealing)
+
+	totalHealingPower := spellCast.Character.GetStat(stats.HealingPower) + healEffect.BonusHealingPower
+	healing := baseHealing + totalHealingPower*healEffect.DirectInput.SpellCoefficient + healEffect.DirectInput.FlatHealingBonus
+	healing *= healEffect.HealingMultiplier
+
+	healEffect.Crit = !healEffect.IgnoreCritCheck && healEffect.critCheck(sim, spellCast)
+	if healEffect.Crit {
+		healing *= spellCast.CritMultiplier
+	}
+	return healing
+}
+
+// Whether this effect has a direct component which is allowed to crit.
+func (healEffect *HealEffect) canCrit() bool {
+	directInput := healEffect.DirectInput
+	hasDirect := directInput.MaxBaseHealing != 0 || directInput.SpellCoefficient != 0 || directInput.FlatHealingBonus != 0
+	return hasDirect && !healEffect.IgnoreCritCheck
+}
+
+// Calculates a crit check using the stats from this heal.
+func (healEffect *HealEffect) critCheck(sim *Simulation, spellCast *SpellCast) bool {
+	critChance := (spellCast.Character.GetStat(stats.SpellCrit) + spellCast.BonusCritRating + healEffect.BonusCritRating) / (SpellCritRatingPerCritChance * 100)
+	return sim.RandomFloat("DirectHeal Crit") < critChance
+}
+
+// Snapshots a few values at the start of a hot.
+func (healEffect *HealEffect) takeHotSnapshot(sim *Simulation, spellCast *SpellCast) {
+	totalHealingPower := spellCast.Character.GetStat(stats.HealingPower) + healEffect.BonusHealingPower
+
+	hot := &healEffect.HotInput
+	hot.startTime = sim.CurrentTime
+	hot.finalTickTime = sim.CurrentTime + hot.FullDuration()
+	hot.healingPerTick = (hot.TickBaseHealing + totalHealingPower*hot.TickSpellCoefficient) * healEffect.HealingMultiplier
+	hot.tickIndex = 0
+}
+
+// Heals the target and records the results on the cast.
+func (healEffect *HealEffect) applyHealing(sim *Simulation, spellCast *SpellCast, healing float64) {
+	healEffect.Healing = healEffect.Target.Heal(sim, healing, spellCast.ActionID)
+	healEffect.Overhealing = healing - healEffect.Healing
+
+	spellCast.Hits++
+	if healEffect.Crit {
+		spellCast.Crits++
+	}
+	spellCast.TotalHealing += healEffect.Healing
+	spellCast.TotalOverhealing += healEffect.Overhealing
+
+	if healEffect.OnHeal != nil {
+		healEffect.OnHeal(sim, spellCast, healEffect)
+	}
+}
+
+func (healEffect *HealEffect) String() string {
+	var sb strings.Builder
+
+	if healEffect.Crit {
+		sb.WriteString("Crit")
+	} else {
+		sb.WriteString("Hit")
+	}
+	fmt.Fprintf(&sb, " %s for %0.3f healing", healEffect.Target.Label, healEffect.Healing)
+	if healEffect.Overhealing > 0 {
+		fmt.Fprintf(&sb, " (%0.3f overhealing)", healEffect.Overhealing)
+	}
+
+	return sb.String()
+}
+
+// SimpleHeal has a single cast and heals one or more players, directly and/or
+// over time.
+type SimpleHeal struct {
+	// Embedded spell cast.
+	SpellCast
+
+	// Individual heal effect of this spell. Use this when the spell heals 1 target.
+	// Only one of this or Effects should be filled, not both.
+	Effect HealEffect
+
+	// Individual heal effects of this spell, for heals which jump between
+	// targets like Chain Heal. Effects are applied in order, and effects
+	// without a target pick the most injured player who hasn't been healed by
+	// this spell yet. Hots are not supported.
+	Effects []HealEffect
+
+	// Players which effects without a target may choose from. If empty, any
+	// player in the raid may be chosen.
+	SmartTargets []*Character
+
+	// The action currently used for the hot effect of this spell, or nil if not ticking.
+	currentHotAction *PendingAction
+}
+
+// Init will call any 'OnCast' effects associated with the caster and then apply
+// spell haste to the cast. Init will panic if the spell or the GCD is still on CD.
+func (heal *SimpleHeal) Init(sim *Simulation) {
+	heal.SpellCast.init(sim)
+}
+
+func (heal *SimpleHeal) Cast(sim *Simulation) bool {
+	return heal.startCasting(sim, func(sim *Simulation, cast *Cast) {
+		heal.applyEffects(sim)
+	})
+}
+
+func (heal *SimpleHeal) applyEffects(sim *Simulation) {
+	if len(heal.Effects) == 0 {
+		healEffect := &heal.Effect
+		if healEffect.Target == nil {
+			healEffect.Target = heal.mostInjuredTarget(sim, nil)
+		}
+
+		if healEffect.Target != nil {
+			if healEffect.DirectInput.MaxBaseHealing != 0 {
+				healing := healEffect.calculateDirectHealing(sim, &heal.SpellCast)
+				healEffect.applyHealing(sim, &heal.SpellCast, healing)
+				if sim.Log != nil {
+					heal.Character.Log(sim, "%s %s.", heal.ActionID, healEffect)
+				}
+			}
+
+			if healEffect.HotInput.NumberOfTicks != 0 {
+				heal.startHot(sim)
+			}
+		}
+	} else {
+		healed := make([]*Character, 0, len(heal.Effects))
+		for effectIdx := range heal.Effects {
+			healEffect := &heal.Effects[effectIdx]
+			if healEffect.Target == nil {
+				healEffect.Target = heal.mostInjuredTarget(sim, healed)
+			}
+			if healEffect.Target == nil {
+				// Nobody left to heal, so the heal doesn't jump any further.
+				break
+			}
+
+			healing := healEffect.calculateDirectHealing(sim, &heal.SpellCast)
+			healEffect.applyHealing(sim, &heal.SpellCast, healing)
+			if sim.Log != nil {
+				heal.Character.Log(sim, "%s %s.", heal.ActionID, healEffect)
+			}
+			healed = append(healed, healEffect.Target)
+		}
+	}
+
+	if heal.currentHotAction == nil {
+		heal.Character.Metrics.AddSpellCast(&heal.SpellCast)
+		heal.objectInUse = false
+	}
+}
+
+func (heal *SimpleHeal) startHot(sim *Simulation) {
+	healEffect := &heal.Effect
+	healEffect.takeHotSnapshot(sim, &heal.SpellCast)
+
+	pa := sim.pendingActionPool.Get()
+	pa.Priority = ActionPriorityDOT
+	pa.NextActionAt = sim.CurrentTime + healEffect.HotInput.TickLength
+	pa.OnAction = func(sim *Simulation) {
+		healEffect.Crit = false
+		healEffect.applyHealing(sim, &heal.SpellCast, healEffect.HotInput.healingPerTick)
+		if sim.Log != nil {
+			heal.Character.Log(sim, "%s ticked %s.", heal.ActionID, healEffect)
+		}
+		healEffect.HotInput.tickIndex++
+
+		if healEffect.HotInput.tickIndex < healEffect.HotInput.NumberOfTicks {
+			// Refresh action.
+			pa.NextActionAt = sim.CurrentTime + healEffect.HotInput.TickLength
+			sim.AddPendingAction(pa)
+		} else {
+			if healEffect.HotInput.OnExpire != nil {
+				healEffect.HotInput.OnExpire(sim, &heal.SpellCast, healEffect)
+			}
+			pa.CleanUp(sim)
+		}
+	}
+	pa.CleanUp = func(sim *Simulation) {
+		if pa.cancelled {
+			return
+		}
+		pa.cancelled = true
+		if heal.currentHotAction != nil {
+			heal.currentHotAction.cancelled = true
+			heal.currentHotAction = nil
+		}
+
+		// Clean up the hot object.
+		healEffect.HotInput.finalTickTime = 0
+
+		heal.Character.Metrics.AddSpellCast(&heal.SpellCast)
+		heal.objectInUse = false
+	}
+
+	heal.currentHotAction = pa
+	sim.AddPendingAction(pa)
+}
+
+// Returns the most injured living player among the smart targets, not
+// including the excluded players, or nil if none of them are injured.
+func (heal *SimpleHeal) mostInjuredTarget(sim *Simulation, exclude []*Character) *Character {
+	candidates := heal.SmartTargets
+	if len(candidates) == 0 {
+		candidates = sim.Raid.AllCharacters()
+	}
+	return MostInjuredCharacter(candidates, exclude)
+}
+
+func (heal *SimpleHeal) Cancel(sim *Simulation) {
+	heal.SpellCast.Cancel()
+	if heal.currentHotAction != nil {
+		heal.currentHotAction.Cancel(sim)
+		heal.currentHotAction = nil
+	}
+}
+
+type SimpleHealTemplate struct {
+	template SimpleHeal
+	effects  []HealEffect
+}
+
+func (template *SimpleHealTemplate) Apply(newAction *SimpleHeal) {
+	if newAction.objectInUse {
+		panic(fmt.Sprintf("Heal (%s) already in use", newAction.ActionID))
+	}
+	*newAction = template.template
+	newAction.Effects = template.effects
+	copy(newAction.Effects, template.template.Effects)
+}
+
+// Takes in a heal template and returns a template, so you don't need to keep track of which things to allocate yourself.
+func NewSimpleHealTemplate(healTemplate SimpleHeal) SimpleHealTemplate {
+	if len(healTemplate.Effects) > 0 && healTemplate.Effect.HealingMultiplier != 0 {
+		panic("Cannot use both Effect and Effects, pick one!")
+	}
+	for _, healEffect := range healTemplate.Effects {
+		if healEffect.HotInput.NumberOfTicks != 0 {
+			panic("Hots are only supported on heals with a single Effect!")
+		}
+	}
+	if healTemplate.SpellCast.CritMultiplier == 0 {
+		if healTemplate.Effect.canCrit() {
+			panic("Heal " + healTemplate.ActionID.String() + " can crit but has no CritMultiplier!")
+		}
+		for _, healEffect := range healTemplate.Effects {
+			if healEffect.canCrit() {
+				panic("Heal " + healTemplate.ActionID.String() + " can crit but has no CritMultiplier!")
+			}
+		}
+	}
+
+	return SimpleHealTemplate{
+		template: healTemplate,
+		effects:  make([]HealEffect, len(healTemplate.Effects)),
+	}
+}
+
+// Returns the living character missing the most health, not including the
+// excluded characters, or nil if none of them are missing any health.
+func MostInjuredCharacter(characters []*Character, exclude []*Character) *Character {
+	var mostInjured *Character
+	mostMissing := 0.0
+
+	for _, character := range characters {
+		excluded := false
+		for _, excludedCharacter := range exclude {
+			if character == excludedCharacter {
+				excluded = true
+				break
+			}
+		}
+		if excluded {
+			continue
+		}
+
+		if missing := character.MissingHealth(); missing > mostMissing {
+			mostInjured = character
+			mostMissing = missing
+		}
+	}
+
+	return mostInjured
+}
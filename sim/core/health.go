@@ -0,0 +1,264 @@
+package core
+
+import (
+	"math"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+// Health fraction below which a target with health is in execute range.
+const ExecutePhaseHealthFraction = 0.2
+
+// Whether this target has health, i.e. whether it can die.
+func (target *Target) HasHealth() bool {
+	return target.MaxHealth > 0
+}
+
+func (target *Target) CurrentHealth() float64 {
+	return target.currentHealth
+}
+
+// Current health as a fraction of max health, from 0-1. Always 1 for targets
+// without health.
+func (target *Target) CurrentHealthPercent() float64 {
+	if !target.HasHealth() {
+		return 1
+	}
+	return target.currentHealth / target.MaxHealth
+}
+
+func (target *Target) IsDead() bool {
+	return target.dead
+}
+
+// Records damage dealt to this target by the given character's action, and
+// reduces this target's health, killing it if its health runs out.
+func (target *Target) takeDamage(sim *Simulation, character *Character, actionID ActionID, school stats.Stat, damage float64) {
+	if damage <= 0 {
+		return
+	}
+
+	// Pets share their owner's raid index, so their damage counts towards the owner.
+	target.damageTaken.Total += damage
+	target.damageByPlayer[int32(character.RaidIndex)] += damage
+	target.addDamageTaken(int32(character.RaidIndex), actionID, school, damage)
+
+	if !target.HasHealth() || target.dead {
+		return
+	}
+
+	target.currentHealth -= damage
+	if target.currentHealth > 0 {
+		sim.encounter.checkHealthPhases(sim, target)
+		return
+	}
+
+	target.overkill -= target.currentHealth
+	target.currentHealth = 0
+	target.dead = true
+	target.deathTime = sim.CurrentTime
+	target.active = false
+	target.DamageTakenMultiplier = 0
+	if sim.Log != nil {
+		target.Log(sim, "Died.")
+	}
+	sim.encounter.checkHealthPhases(sim, target)
+
+	if sim.encounter.EndWhenTargetsDie && sim.encounter.allTargetsDead() {
+		// Ending the iteration now makes DPS use the actual fight length.
+		sim.Duration = sim.CurrentTime
+	}
+}
+
+// Health gained per point of stamina above the first 20.
+const HealthPerStamina = 10
+
+// Level 70 base health for each class, before stamina.
+var ClassBaseHealth = map[proto.Class]float64{
+	proto.Class_ClassDruid:   3434,
+	proto.Class_ClassHunter:  3488,
+	proto.Class_ClassMage:    3213,
+	proto.Class_ClassPaladin: 3377,
+	proto.Class_ClassPriest:  3211,
+	proto.Class_ClassRogue:   3524,
+	proto.Class_ClassShaman:  3458,
+	proto.Class_ClassWarlock: 3310,
+	proto.Class_ClassWarrior: 4264,
+}
+
+// Assumes all characters have >= 20 stamina. Pets have no class, so their
+// health only comes from stamina.
+func (character *Character) MaxHealth() float64 {
+	return ClassBaseHealth[character.Class] + 20 + HealthPerStamina*(character.GetInitialStat(stats.Stamina)-20)
+}
+
+func (character *Character) CurrentHealth() float64 {
+	return character.currentHealth
+}
+
+func (character *Character) IsDead() bool {
+	return character.dead
+}
+
+// Callback for after a character takes damage from an enemy, if it survives.
+type OnDamageTaken func(sim *Simulation, character *Character, damage float64)
+
+// Reduces this character's health by damage from an enemy. A character whose
+// health runs out dies, and stops acting for the rest of the iteration.
+func (character *Character) TakeDamage(sim *Simulation, damage float64, actionID ActionID) {
+	if damage <= 0 || character.dead {
+		return
+	}
+
+	if sim.Log != nil {
+		character.Log(sim, "Took %0.3f damage from %s (%0.3f --> %0.3f).", damage, actionID, character.currentHealth, character.currentHealth-damage)
+	}
+
+	character.Metrics.DamageTaken += damage
+	character.currentHealth -= damage
+	if character.currentHealth > 0 {
+		character.auraTracker.OnDamageTaken(sim, character, damage)
+		return
+	}
+
+	character.Metrics.Overkill -= character.currentHealth
+	character.Metrics.Died = true
+	character.currentHealth = 0
+	character.dead = true
+	if sim.Log != nil {
+		character.Log(sim, "Died.")
+	}
+
+	character.interruptHardcast(sim)
+	character.AutoAttacks.CancelAutoSwing(sim)
+}
+
+// Restores this character's health, up to its max health, and returns the
+// amount of health restored. Dead characters can't be healed.
+func (character *Character) Heal(sim *Simulation, amount float64, actionID ActionID) float64 {
+	if amount < 0 {
+		panic("Trying to heal negative health!")
+	}
+	if character.dead {
+		return 0
+	}
+
+	oldHealth := character.currentHealth
+	newHealth := MinFloat(oldHealth+amount, character.MaxHealth())
+
+	if sim.Log != nil {
+		character.Log(sim, "Healed %0.3f from %s (%0.3f --> %0.3f).", amount, actionID, oldHealth, newHealth)
+	}
+
+	character.currentHealth = newHealth
+	character.Metrics.HealingReceived += newHealth - oldHealth
+	return newHealth - oldHealth
+}
+
+// Health below max health, i.e. how much healing this character can receive
+// without overhealing.
+func (character *Character) MissingHealth() float64 {
+	if character.dead {
+		return 0
+	}
+	return character.MaxHealth() - character.currentHealth
+}
+
+func (encounter *Encounter) hasHealth() bool {
+	for _, target := range encounter.Targets {
+		if target.HasHealth() {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether every target with health has died.
+func (encounter *Encounter) allTargetsDead() bool {
+	for _, target := range encounter.Targets {
+		if target.HasHealth() && !target.dead {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the time at which the last target with health died, and whether
+// they all died.
+func (encounter *Encounter) killTime() (time.Duration, bool) {
+	if !encounter.allTargetsDead() {
+		return 0, false
+	}
+
+	killTime := time.Duration(0)
+	for _, target := range encounter.Targets {
+		if target.HasHealth() {
+			killTime = MaxDuration(killTime, target.deathTime)
+		}
+	}
+	return killTime, true
+}
+
+// Estimates the time remaining until all targets with health die, based on
+// the damage they've taken so far. Returns false if there isn't enough
+// information yet.
+func (encounter *Encounter) estimatedTimeToKill(sim *Simulation) (time.Duration, bool) {
+	if sim.CurrentTime == 0 {
+		return 0, false
+	}
+
+	damageTaken := 0.0
+	healthRemaining := 0.0
+	for _, target := range encounter.Targets {
+		if target.HasHealth() {
+			damageTaken += target.MaxHealth - target.currentHealth
+			healthRemaining += target.currentHealth
+		}
+	}
+	if damageTaken == 0 {
+		return 0, false
+	}
+
+	return time.Duration(healthRemaining / damageTaken * float64(sim.CurrentTime)), true
+}
+
+// Aggregates time-to-kill across iterations.
+type timeToKillMetrics struct {
+	numKills   int32
+	sum        float64
+	sumSquared float64
+	max        float64
+	hist       map[int32]int32 // time to kill in whole seconds to count
+}
+
+func newTimeToKillMetrics() timeToKillMetrics {
+	return timeToKillMetrics{
+		hist: make(map[int32]int32),
+	}
+}
+
+func (ttkMetrics *timeToKillMetrics) addKill(killTime time.Duration) {
+	seconds := killTime.Seconds()
+
+	ttkMetrics.numKills++
+	ttkMetrics.sum += seconds
+	ttkMetrics.sumSquared += seconds * seconds
+	ttkMetrics.max = MaxFloat(ttkMetrics.max, seconds)
+	ttkMetrics.hist[int32(math.Round(seconds))]++
+}
+
+func (ttkMetrics *timeToKillMetrics) ToProto() *proto.DistributionMetrics {
+	if ttkMetrics.numKills == 0 {
+		return &proto.DistributionMetrics{}
+	}
+
+	avg := ttkMetrics.sum / float64(ttkMetrics.numKills)
+	return &proto.DistributionMetrics{
+		Avg:   avg,
+		Stdev: math.Sqrt(MaxFloat(0, ttkMetrics.sumSquared/float64(ttkMetrics.numKills)-avg*avg)),
+		Max:   ttkMetrics.max,
+		Hist:  ttkMetrics.hist,
+	}
+}
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+func TestTargetHealth(t *testing.T) {
+	encounter := NewEncounter(proto.Encounter{
+		Duration:          300,
+		ExecuteProportion: 0.2,
+		EndWhenTargetsDie: true,
+		Targets:           []*proto.Target{{Health: 1000}, {Health: 500}},
+	})
+	sim := &Simulation{
+		encounter: encounter,
+		Duration:  time.Second * 300,
+	}
+	for _, target := range sim.encounter.Targets {
+		target.active = true
+		target.DamageTakenMultiplier = 1
+		target.currentHealth = target.MaxHealth
+	}
+	boss := sim.GetTarget(0)
+	add := sim.GetTarget(1)
+	character := &Character{}
+	actionID := ActionID{SpellID: 1}
+
+	sim.CurrentTime = time.Second * 10
+	boss.takeDamage(sim, character, actionID, stats.FireSpellPower, 850)
+	if !sim.IsExecutePhase() {
+		t.Fatalf("Expected execute phase at %0.2f health", boss.CurrentHealthPercent())
+	}
+	if remaining := sim.GetRemainingDuration(); remaining != time.Duration(650.0/850.0*float64(sim.CurrentTime)) {
+		t.Fatalf("Expected estimated time to kill, got %s", remaining)
+	}
+
+	sim.CurrentTime = time.Second * 20
+	boss.takeDamage(sim, character, actionID, stats.FireSpellPower, 200)
+	if !boss.IsDead() || boss.IsActive() || sim.GetPrimaryTarget() != add {
+		t.Fatalf("Expected boss to die and the add to become the primary target")
+	}
+	if sim.Duration != time.Second*300 {
+		t.Fatalf("Iteration should not end while the add is alive")
+	}
+
+	sim.CurrentTime = time.Second * 30
+	add.takeDamage(sim, character, actionID, stats.FireSpellPower, 500)
+	if sim.Duration != sim.CurrentTime {
+		t.Fatalf("Expected iteration to end when all targets die")
+	}
+
+	sim.encounter.doneIteration(sim.Duration)
+	metrics := sim.encounter.GetMetricsProto(1)
+	if metrics.KillChance != 1 || metrics.TimeToKill.Avg != 30 {
+		t.Fatalf("Expected a 30s kill, got %v", metrics)
+	}
+	if metrics.Targets[0].DamageByPlayerAvg[0] != 1050 {
+		t.Fatalf("Expected 1050 damage to the boss, got %0.1f", metrics.Targets[0].DamageByPlayerAvg[0])
+	}
+	if metrics.Targets[0].OverkillAvg != 50 {
+		t.Fatalf("Expected 50 overkill on the boss, got %0.1f", metrics.Targets[0].OverkillAvg)
+	}
+	if damageTaken := metrics.Targets[0].DamageTaken; len(damageTaken) != 1 ||
+		damageTaken[0].Hits != 2 ||
+		damageTaken[0].DamageAvg != 1050 ||
+		damageTaken[0].School != proto.SpellSchool_SpellSchoolFire {
+		t.Fatalf("Expected the boss's damage taken to come from a single fire source, got %v", damageTaken)
+	}
+}
+
+func TestCharacterHealth(t *testing.T) {
+	sim := &Simulation{
+		Duration: time.Second * 300,
+	}
+	character := &Character{
+		Class:   proto.Class_ClassPriest,
+		Metrics: NewCharacterMetrics(),
+	}
+	character.initialStats[stats.Stamina] = 100
+	character.currentHealth = character.MaxHealth()
+	if character.MaxHealth() != 4031 {
+		t.Fatalf("Expected 4031 health from priest base health and 100 stamina, got %0.1f", character.MaxHealth())
+	}
+
+	character.TakeDamage(sim, 500, ActionID{})
+	character.Heal(sim, 600, ActionID{})
+	if character.CurrentHealth() != 4031 || character.Metrics.HealingReceived != 500 {
+		t.Fatalf("Expected healing to be capped at max health, got %0.1f", character.Metrics.HealingReceived)
+	}
+
+	character.TakeDamage(sim, 5000, ActionID{})
+	if !character.IsDead() {
+		t.Fatalf("Expected character to die")
+	}
+
+	character.Metrics.doneIteration(sim.Duration.Seconds())
+	metrics := character.Metrics.ToProto(1)
+	if metrics.DamageTakenAvg != 5500 || metrics.OverkillAvg != 969 || metrics.DeathChance != 1 {
+		t.Fatalf("Unexpected survivability metrics: %v", metrics)
+	}
+}
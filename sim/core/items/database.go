@@ -0,0 +1,265 @@
+package items
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Version of the ItemDatabase proto format. Bump this whenever the meaning of
+// existing fields changes, so stale database files are rejected instead of
+// silently producing wrong results.
+const DatabaseVersion = 1
+
+// A set of items, gems, and enchants along with lookup tables for each.
+type Database struct {
+	Items    []Item
+	Gems     []Gem
+	Enchants []Enchant
+
+	ByName         map[string]Item
+	ByID           map[int32]Item
+	GemsByName     map[string]Gem
+	GemsByID       map[int32]Gem
+	EnchantsByName map[string]Enchant
+	EnchantsByID   map[int32]Enchant
+}
+
+// The database used by all lookups which don't specify one. Initially this
+// holds the data compiled into the binary, but it can be replaced at startup
+// via LoadDatabase().
+var DefaultDatabase *Database
+
+func NewDatabase(items []Item, gems []Gem, enchants []Enchant) (*Database, error) {
+	db := &Database{
+		Items:    items,
+		Gems:     gems,
+		Enchants: enchants,
+
+		ByName:         make(map[string]Item, len(items)),
+		ByID:           make(map[int32]Item, len(items)),
+		GemsByName:     make(map[string]Gem, len(gems)),
+		GemsByID:       make(map[int32]Gem, len(gems)),
+		EnchantsByName: make(map[string]Enchant, len(enchants)),
+		EnchantsByID:   make(map[int32]Enchant, len(enchants)),
+	}
+
+	for _, v := range enchants {
+		db.EnchantsByName[v.Name] = v
+		db.EnchantsByID[v.ID] = v
+	}
+	for _, v := range gems {
+		db.GemsByName[v.Name] = v
+		db.GemsByID[v.ID] = v
+	}
+	for _, v := range items {
+		if _, ok := db.ByID[v.ID]; ok {
+			return nil, fmt.Errorf("found dup item: %s (%d)", v.Name, v.ID)
+		}
+		db.ByName[v.Name] = v
+		db.ByID[v.ID] = v
+	}
+
+	return db, nil
+}
+
+// Converts an ItemDatabase proto into a Database.
+func DatabaseFromProto(dbProto *proto.ItemDatabase) (*Database, error) {
+	if dbProto.Version != DatabaseVersion {
+		return nil, fmt.Errorf("unsupported item database version %d, expected %d", dbProto.Version, DatabaseVersion)
+	}
+
+	items := make([]Item, len(dbProto.Items))
+	for i, itemProto := range dbProto.Items {
+		items[i] = ItemFromProto(itemProto)
+	}
+	gems := make([]Gem, len(dbProto.Gems))
+	for i, gemProto := range dbProto.Gems {
+		gems[i] = GemFromProto(gemProto)
+	}
+	enchants := make([]Enchant, len(dbProto.Enchants))
+	for i, enchantProto := range dbProto.Enchants {
+		enchants[i] = EnchantFromProto(enchantProto)
+	}
+
+	return NewDatabase(items, gems, enchants)
+}
+
+func (db *Database) ToProto() *proto.ItemDatabase {
+	dbProto := &proto.ItemDatabase{
+		Version: DatabaseVersion,
+	}
+	for _, item := range db.Items {
+		dbProto.Items = append(dbProto.Items, item.ToProto())
+	}
+	for _, gem := range db.Gems {
+		dbProto.Gems = append(dbProto.Gems, gem.ToProto())
+	}
+	for _, enchant := range db.Enchants {
+		dbProto.Enchants = append(dbProto.Enchants, enchant.ToProto())
+	}
+	return dbProto
+}
+
+// Returns a new Database containing everything in db plus the custom entries.
+// Custom entries with the same ID as an existing entry replace it. db is not
+// modified, so this is safe to use per-request while other sims are running.
+func (db *Database) WithCustomItems(custom *proto.ItemDatabase) (*Database, error) {
+	if custom == nil || (len(custom.Items) == 0 && len(custom.Gems) == 0 && len(custom.Enchants) == 0) {
+		return db, nil
+	}
+
+	customDB, err := DatabaseFromProto(custom)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, 0, len(db.Items)+len(customDB.Items))
+	for _, item := range db.Items {
+		if _, ok := customDB.ByID[item.ID]; !ok {
+			items = append(items, item)
+		}
+	}
+	items = append(items, customDB.Items...)
+
+	gems := make([]Gem, 0, len(db.Gems)+len(customDB.Gems))
+	for _, gem := range db.Gems {
+		if _, ok := customDB.GemsByID[gem.ID]; !ok {
+			gems = append(gems, gem)
+		}
+	}
+	gems = append(gems, customDB.Gems...)
+
+	enchants := make([]Enchant, 0, len(db.Enchants)+len(customDB.Enchants))
+	for _, enchant := range db.Enchants {
+		if _, ok := customDB.EnchantsByID[enchant.ID]; !ok {
+			enchants = append(enchants, enchant)
+		}
+	}
+	enchants = append(enchants, customDB.Enchants...)
+
+	return NewDatabase(items, gems, enchants)
+}
+
+// Replaces the default database, and the package-level lookup tables, with the
+// given one. This is not thread-safe and should only be called during startup.
+func SetDefaultDatabase(db *Database) {
+	DefaultDatabase = db
+
+	Items = db.Items
+	Gems = db.Gems
+	Enchants = db.Enchants
+
+	ByName = db.ByName
+	ByID = db.ByID
+	GemsByName = db.GemsByName
+	GemsByID = db.GemsByID
+	EnchantsByName = db.EnchantsByName
+	EnchantsByID = db.EnchantsByID
+}
+
+// Loads an ItemDatabase proto and uses it as the default database.
+func LoadDatabase(dbProto *proto.ItemDatabase) error {
+	db, err := DatabaseFromProto(dbProto)
+	if err != nil {
+		return err
+	}
+	SetDefaultDatabase(db)
+	return nil
+}
+
+// Loads the default database from a file. Files ending in .json are parsed as
+// JSON, anything else as binary proto.
+func LoadDatabaseFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	dbProto := &proto.ItemDatabase{}
+	if strings.HasSuffix(path, ".json") {
+		err = protojson.Unmarshal(data, dbProto)
+	} else {
+		err = googleProto.Unmarshal(data, dbProto)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse item database %s: %s", path, err)
+	}
+
+	return LoadDatabase(dbProto)
+}
+
+// Writes the database to a file, in the format LoadDatabaseFile expects for the
+// given path.
+func (db *Database) WriteFile(path string) error {
+	var data []byte
+	var err error
+	if strings.HasSuffix(path, ".json") {
+		data, err = protojson.MarshalOptions{Multiline: true}.Marshal(db.ToProto())
+	} else {
+		data, err = googleProto.Marshal(db.ToProto())
+	}
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+func ItemFromProto(itemProto *proto.Item) Item {
+	item := Item{
+		ID:               itemProto.Id,
+		WowheadID:        itemProto.WowheadId,
+		Type:             itemProto.Type,
+		ArmorType:        itemProto.ArmorType,
+		WeaponType:       itemProto.WeaponType,
+		HandType:         itemProto.HandType,
+		RangedWeaponType: itemProto.RangedWeaponType,
+		WeaponDamageMin:  itemProto.WeaponDamageMin,
+		WeaponDamageMax:  itemProto.WeaponDamageMax,
+		SwingSpeed:       itemProto.WeaponSpeed,
+		ClassAllowlist:   itemProto.ClassAllowlist,
+		Name:             itemProto.Name,
+		Phase:            byte(itemProto.Phase),
+		Quality:          itemProto.Quality,
+		Unique:           itemProto.Unique,
+		Ilvl:             itemProto.Ilvl,
+		GemSockets:       itemProto.GemSockets,
+		SetName:          itemProto.SetName,
+		HasSpecialEffect: itemProto.HasSpecialEffect,
+	}
+	copy(item.Stats[:], itemProto.Stats)
+	copy(item.SocketBonus[:], itemProto.SocketBonus)
+	return item
+}
+
+func GemFromProto(gemProto *proto.Gem) Gem {
+	gem := Gem{
+		ID:      gemProto.Id,
+		Name:    gemProto.Name,
+		Color:   gemProto.Color,
+		Phase:   byte(gemProto.Phase),
+		Quality: gemProto.Quality,
+		Unique:  gemProto.Unique,
+	}
+	copy(gem.Stats[:], gemProto.Stats)
+	return gem
+}
+
+func EnchantFromProto(enchantProto *proto.Enchant) Enchant {
+	enchant := Enchant{
+		ID:          enchantProto.Id,
+		EffectID:    enchantProto.EffectId,
+		Name:        enchantProto.Name,
+		IsSpellID:   enchantProto.IsSpellId,
+		Quality:     enchantProto.Quality,
+		ItemType:    enchantProto.Type,
+		EnchantType: enchantProto.EnchantType,
+		Phase:       enchantProto.Phase,
+	}
+	copy(enchant.Bonus[:], enchantProto.Stats)
+	return enchant
+}
@@ -0,0 +1,98 @@
+package items
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestDatabaseProtoRoundTrip(t *testing.T) {
+	db, err := DatabaseFromProto(DefaultDatabase.ToProto())
+	if err != nil {
+		t.Fatalf("Failed to load database: %s", err)
+	}
+
+	if len(db.ByID) != len(DefaultDatabase.ByID) {
+		t.Fatalf("Expected %d items but got %d", len(DefaultDatabase.ByID), len(db.ByID))
+	}
+	if len(db.GemsByID) != len(DefaultDatabase.GemsByID) {
+		t.Fatalf("Expected %d gems but got %d", len(DefaultDatabase.GemsByID), len(db.GemsByID))
+	}
+	if len(db.EnchantsByID) != len(DefaultDatabase.EnchantsByID) {
+		t.Fatalf("Expected %d enchants but got %d", len(DefaultDatabase.EnchantsByID), len(db.EnchantsByID))
+	}
+
+	for id, item := range DefaultDatabase.ByID {
+		if !db.ByID[id].Stats.Equals(item.Stats) {
+			t.Fatalf("Stats for item %s did not round trip: %s, %s", item.Name, item.Stats, db.ByID[id].Stats)
+		}
+	}
+}
+
+func TestDatabaseBadVersion(t *testing.T) {
+	_, err := DatabaseFromProto(&proto.ItemDatabase{Version: DatabaseVersion + 1})
+	if err == nil {
+		t.Fatalf("Expected error for unsupported database version")
+	}
+}
+
+func TestDatabaseWithCustomItems(t *testing.T) {
+	var existing Item
+	for _, item := range DefaultDatabase.Items {
+		existing = item
+		break
+	}
+
+	override := existing.ToProto()
+	override.Name = "Custom Override"
+	custom := &proto.ItemDatabase{
+		Version: DatabaseVersion,
+		Items: []*proto.Item{
+			override,
+			{Id: 999999, Name: "Custom New Item", Type: proto.ItemType_ItemTypeHead},
+		},
+	}
+
+	db, err := DefaultDatabase.WithCustomItems(custom)
+	if err != nil {
+		t.Fatalf("Failed to merge custom items: %s", err)
+	}
+
+	if db.ByID[existing.ID].Name != "Custom Override" {
+		t.Fatalf("Expected custom item to override existing item, got %s", db.ByID[existing.ID].Name)
+	}
+	if db.ByID[999999].Name != "Custom New Item" {
+		t.Fatalf("Expected custom item to be added")
+	}
+	if len(db.Items) != len(DefaultDatabase.Items)+1 {
+		t.Fatalf("Expected %d items but got %d", len(DefaultDatabase.Items)+1, len(db.Items))
+	}
+	if DefaultDatabase.ByID[existing.ID].Name != existing.Name {
+		t.Fatalf("Default database should not be modified by custom items")
+	}
+}
+
+func TestDatabaseFileRoundTrip(t *testing.T) {
+	for _, fileName := range []string{"item_database.json", "item_database.bin"} {
+		path := filepath.Join(t.TempDir(), fileName)
+		if err := DefaultDatabase.WriteFile(path); err != nil {
+			t.Fatalf("Failed to write %s: %s", fileName, err)
+		}
+
+		defaultDB := DefaultDatabase
+		err := LoadDatabaseFile(path)
+		loadedDB := DefaultDatabase
+		SetDefaultDatabase(defaultDB)
+		if err != nil {
+			t.Fatalf("Failed to load %s: %s", fileName, err)
+		}
+
+		if len(loadedDB.ByID) != len(defaultDB.ByID) {
+			t.Fatalf("Expected %d items in %s but got %d", len(defaultDB.ByID), fileName, len(loadedDB.ByID))
+		}
+		if len(loadedDB.EnchantsByID) != len(defaultDB.EnchantsByID) {
+			t.Fatalf("Expected %d enchants in %s but got %d", len(defaultDB.EnchantsByID), fileName, len(loadedDB.EnchantsByID))
+		}
+	}
+}
@@ -16,15 +16,6 @@ var EnchantsByName = map[string]Enchant{}
 var EnchantsByID = map[int32]Enchant{}
 
 func init() {
-	for _, v := range Enchants {
-		EnchantsByName[v.Name] = v
-		EnchantsByID[v.ID] = v
-	}
-	for _, v := range Gems {
-		GemsByName[v.Name] = v
-		GemsByID[v.ID] = v
-	}
-
 	// Add hard-coded items. Wowhead doesn't seem to have tooltips for random enchant items.
 	// Use negative IDs to avoid collisions with real item IDs.
 	Items = append(Items, []Item{
@@ -53,14 +44,13 @@ func init() {
 		{Name: "Dragonstrike P5", WowheadID: 28439, ID: -23, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 184.0, WeaponDamageMax: 343.0, SwingSpeed: 2.70, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Stamina: 19}, SocketBonus: stats.Stats{}},
 	}...)
 
-	for _, v := range Items {
-		if _, ok := ByID[v.ID]; ok {
-			fmt.Printf("Found dup item: %s\n", v.Name)
-			panic("no dupes allowed")
-		}
-		ByName[v.Name] = v
-		ByID[v.ID] = v
+	// The compiled-in data is the default, but may be replaced at startup with
+	// LoadDatabaseFile().
+	db, err := NewDatabase(Items, Gems, Enchants)
+	if err != nil {
+		panic(err)
 	}
+	SetDefaultDatabase(db)
 }
 
 type Item struct {
@@ -91,6 +81,13 @@ type Item struct {
 	GemSockets  []proto.GemColor
 	SocketBonus stats.Stats
 
+	// Name of the item set this item is part of, if any.
+	SetName string
+
+	// Whether the item has Equip / Use / Chance on hit effects beyond plain
+	// stats. These need to be implemented with core.AddItemEffect().
+	HasSpecialEffect bool
+
 	// Modified for each instance of the item.
 	Gems    []Gem
 	Enchant Enchant
@@ -117,6 +114,8 @@ func (item Item) ToProto() *proto.Item {
 		Ilvl:             item.Ilvl,
 		GemSockets:       item.GemSockets,
 		SocketBonus:      item.SocketBonus[:],
+		SetName:          item.SetName,
+		HasSpecialEffect: item.HasSpecialEffect,
 	}
 }
 
@@ -256,15 +255,19 @@ func ProtoToEquipmentSpec(es proto.EquipmentSpec) EquipmentSpec {
 }
 
 func NewItem(itemSpec ItemSpec) Item {
+	return DefaultDatabase.NewItem(itemSpec)
+}
+
+func (db *Database) NewItem(itemSpec ItemSpec) Item {
 	item := Item{}
-	if foundItem, ok := ByID[itemSpec.ID]; ok {
+	if foundItem, ok := db.ByID[itemSpec.ID]; ok {
 		item = foundItem
 	} else {
 		panic(fmt.Sprintf("No item with id: %d", itemSpec.ID))
 	}
 
 	if itemSpec.Enchant != 0 {
-		if enchant, ok := EnchantsByID[itemSpec.Enchant]; ok {
+		if enchant, ok := db.EnchantsByID[itemSpec.Enchant]; ok {
 			item.Enchant = enchant
 		} else {
 			panic(fmt.Sprintf("No enchant with id: %d", itemSpec.Enchant))
@@ -277,7 +280,7 @@ func NewItem(itemSpec ItemSpec) Item {
 			if gemIdx >= len(item.GemSockets) {
 				break // in case we get invalid gem settings.
 			}
-			if gem, ok := GemsByID[gemID]; ok {
+			if gem, ok := db.GemsByID[gemID]; ok {
 				item.Gems[gemIdx] = gem
 			} else {
 				if gemID != 0 {
@@ -290,17 +293,25 @@ func NewItem(itemSpec ItemSpec) Item {
 }
 
 func NewEquipmentSet(equipSpec EquipmentSpec) Equipment {
+	return DefaultDatabase.NewEquipmentSet(equipSpec)
+}
+
+func (db *Database) NewEquipmentSet(equipSpec EquipmentSpec) Equipment {
 	equipment := Equipment{}
 	for _, itemSpec := range equipSpec {
 		if itemSpec.ID != 0 {
-			equipment.EquipItem(NewItem(itemSpec))
+			equipment.EquipItem(db.NewItem(itemSpec))
 		}
 	}
 	return equipment
 }
 
 func ProtoToEquipment(es proto.EquipmentSpec) Equipment {
-	return NewEquipmentSet(ProtoToEquipmentSpec(es))
+	return DefaultDatabase.ProtoToEquipment(es)
+}
+
+func (db *Database) ProtoToEquipment(es proto.EquipmentSpec) Equipment {
+	return db.NewEquipmentSet(ProtoToEquipmentSpec(es))
 }
 
 // Like ItemSpec, but uses names for reference instead of ID.
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// Models the delays a real player adds between actions, from network latency
+// and human reaction time.
+type Latency struct {
+	// Delay between one action finishing and the next one starting. Added to
+	// the GCD of every cast and ability.
+	ActionLatency time.Duration
+
+	// Delay before reacting to a proc or other change in state, e.g.
+	// Clearcasting, Arcane Blast stacks dropping, or a channel ending.
+	ReactionTime time.Duration
+
+	// Each delay is randomly made shorter or longer by up to this much.
+	Jitter time.Duration
+}
+
+func NewLatency(options *proto.Latency) Latency {
+	if options == nil {
+		return Latency{}
+	}
+
+	return Latency{
+		ActionLatency: DurationFromMillis(options.ActionMs),
+		ReactionTime:  DurationFromMillis(options.ReactionMs),
+		Jitter:        DurationFromMillis(options.JitterMs),
+	}
+}
+
+func DurationFromMillis(numMillis float64) time.Duration {
+	return time.Duration(float64(time.Millisecond) * numMillis)
+}
+
+// Fills in any unset fields with values from defaultLatency.
+func (latency *Latency) applyDefaults(defaultLatency Latency) {
+	if latency.ActionLatency == 0 {
+		latency.ActionLatency = defaultLatency.ActionLatency
+	}
+	if latency.ReactionTime == 0 {
+		latency.ReactionTime = defaultLatency.ReactionTime
+	}
+	if latency.Jitter == 0 {
+		latency.Jitter = defaultLatency.Jitter
+	}
+}
+
+// Returns a random delay around mean. A mean of 0 always gives 0, so jitter
+// doesn't add delays that weren't asked for.
+func (latency Latency) sample(sim *Simulation, mean time.Duration) time.Duration {
+	if mean == 0 || latency.Jitter == 0 {
+		return mean
+	}
+
+	delay := mean + time.Duration(sim.RandomFloat("Latency")*float64(latency.Jitter*2)) - latency.Jitter
+	return MaxDuration(0, delay)
+}
+
+// Returns how long this character waits after an action before starting the next one.
+func (character *Character) ActionDelay(sim *Simulation) time.Duration {
+	return character.Latency.sample(sim, character.Latency.ActionLatency)
+}
+
+// Returns how long this character takes to react to a proc or other change in state.
+func (character *Character) ReactionDelay(sim *Simulation) time.Duration {
+	return character.Latency.sample(sim, character.Latency.ReactionTime)
+}
+
+// Whether the given aura is active and this character has had time to notice
+// it, i.e. it was applied at least one reaction time ago. Refreshing an aura
+// with ReplaceAura() doesn't reset this.
+func (character *Character) HasReactedToAura(sim *Simulation, id AuraID) bool {
+	if !character.HasAura(id) {
+		return false
+	}
+	return sim.CurrentTime-character.auras[id].startTime >= character.Latency.ReactionTime
+}
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestLatency(t *testing.T) {
+	sim := &Simulation{
+		isTest:    true,
+		testRands: make(map[uint32]*rand.Rand),
+	}
+
+	auraID := NewAuraID()
+	character := &Character{
+		auraTracker: newAuraTracker(false),
+		Latency:     NewLatency(&proto.Latency{ReactionMs: 200, JitterMs: 50}),
+	}
+	character.Latency.applyDefaults(NewLatency(&proto.Latency{ActionMs: 30, ReactionMs: 500}))
+
+	if character.Latency.ActionLatency != time.Millisecond*30 || character.Latency.ReactionTime != time.Millisecond*200 {
+		t.Fatalf("Expected only unset fields to use the defaults, got %v", character.Latency)
+	}
+
+	for i := 0; i < 100; i++ {
+		if delay := character.ReactionDelay(sim); delay < time.Millisecond*150 || delay > time.Millisecond*250 {
+			t.Fatalf("Expected reaction delay within 150-250ms, got %s", delay)
+		}
+	}
+
+	character.Latency.ActionLatency = 0
+	if delay := character.ActionDelay(sim); delay != 0 {
+		t.Fatalf("Expected no action delay when action latency is unset, got %s", delay)
+	}
+
+	character.AddAura(sim, Aura{ID: auraID, Expires: NeverExpires})
+	sim.CurrentTime = time.Millisecond * 100
+	if character.HasReactedToAura(sim, auraID) {
+		t.Fatalf("Should not react to an aura before the reaction time has passed")
+	}
+	sim.CurrentTime = time.Millisecond * 200
+	if !character.HasReactedToAura(sim, auraID) {
+		t.Fatalf("Expected to react to the aura once the reaction time has passed")
+	}
+}
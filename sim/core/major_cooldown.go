@@ -19,6 +19,7 @@ const (
 	CooldownTypeUnknown = 0
 	CooldownTypeMana    = 1
 	CooldownTypeDPS     = 2
+	CooldownTypeHealing = 3
 )
 
 // Condition for whether a cooldown can/should be activated.
@@ -56,8 +57,8 @@ type MajorCooldown struct {
 	UsesGCD bool
 
 	// How long before this cooldown takes effect after activation.
-	// Not used yet, but will eventually be important for planning cooldown
-	// schedules.
+	// Cooldowns with a cast time can't be used while moving. This will also
+	// eventually be important for planning cooldown schedules.
 	CastTime time.Duration
 
 	// Cooldowns with higher priority get used first. This is important when some
@@ -123,6 +124,10 @@ func (mcd *MajorCooldown) tryActivate(sim *Simulation, character *Character) boo
 		return false
 	}
 
+	if mcd.CastTime > 0 && character.IsMoving(sim) {
+		return false
+	}
+
 	if !mcd.CanActivate(sim, character) {
 		return false
 	}
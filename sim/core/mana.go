@@ -54,6 +54,7 @@ func (character *Character) AddMana(sim *Simulation, amount float64, actionID Ac
 	}
 
 	character.stats[stats.Mana] = newMana
+	character.Metrics.AddManaGained(actionID, newMana-oldMana, oldMana+amount-newMana)
 	character.Metrics.ManaGained += newMana - oldMana
 	if isBonusMana {
 		character.Metrics.BonusManaGained += newMana - oldMana
@@ -189,6 +190,9 @@ func (sim *Simulation) initManaTickAction() {
 	}
 	pa.OnAction = func(sim *Simulation) {
 		for _, player := range playersWithManaBars {
+			if player.GetCharacter().IsDead() {
+				continue
+			}
 			player.GetCharacter().ManaTick(sim)
 			player.OnManaTick(sim)
 		}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
 )
 
 // A unique number based on an ActionID.
@@ -64,12 +65,23 @@ func NewDistributionMetrics() DistributionMetrics {
 type CharacterMetrics struct {
 	dps    DistributionMetrics
 	threat DistributionMetrics
+	hps    DistributionMetrics
 
 	CharacterIterationMetrics
 
 	// Aggregate values. These are updated after each iteration.
-	oomTimeSum float64
-	actions    map[ActionKey]ActionMetrics
+	oomTimeSum         float64
+	timeToOOMSum       float64
+	movingTimeSum      float64
+	damageTakenSum     float64
+	healingReceivedSum float64
+	overkillSum        float64
+	healingSum         float64
+	overhealingSum     float64
+	manaSpentSum       float64
+	numDeaths          int32
+	actions            map[ActionKey]ActionMetrics
+	manaGained         map[ActionKey]ManaGainedMetrics
 }
 
 // Metrics for the current iteration, for 1 agent. Keep this as a separate
@@ -81,7 +93,19 @@ type CharacterIterationMetrics struct {
 	ManaGained      float64
 	BonusManaGained float64 // Only includes amount from mana pots / runes / innervates.
 
-	OOMTime time.Duration // time spent not casting and waiting for regen.
+	OOMTime    time.Duration // time spent not casting and waiting for regen.
+	TimeToOOM  time.Duration // when the agent first ran out of mana, only valid if hasTimeToOOM is set.
+	MovingTime time.Duration // time spent moving.
+
+	// Whether the agent ran out of mana in this iteration, i.e. whether TimeToOOM is set.
+	hasTimeToOOM bool
+
+	DamageTaken     float64
+	HealingReceived float64 // Doesn't include overhealing.
+	Overkill        float64 // Damage taken beyond what was needed to die.
+	Died            bool
+
+	Overhealing float64 // Healing done beyond the targets' max health.
 }
 
 type ActionMetrics struct {
@@ -100,6 +124,10 @@ type ActionMetrics struct {
 	Glances int32
 
 	Damage float64
+
+	// These will be 0 for actions which don't heal.
+	Healing     float64
+	Overhealing float64
 }
 
 func (actionMetrics *ActionMetrics) ToProto() *proto.ActionMetrics {
@@ -125,14 +153,35 @@ func (actionMetrics *ActionMetrics) ToProto() *proto.ActionMetrics {
 		Blocks:  actionMetrics.Blocks,
 		Glances: actionMetrics.Glances,
 		Damage:  actionMetrics.Damage,
+
+		Healing:     actionMetrics.Healing,
+		Overhealing: actionMetrics.Overhealing,
+	}
+}
+
+// Mana gained from one source, aggregated across iterations.
+type ManaGainedMetrics struct {
+	ActionID ActionID
+
+	ManaGained float64
+	Overflow   float64 // Mana which would have gone above max mana.
+}
+
+func (manaGainedMetrics *ManaGainedMetrics) ToProto(numIterations int32) *proto.ManaGainedMetrics {
+	return &proto.ManaGainedMetrics{
+		Id:            manaGainedMetrics.ActionID.ToProto(),
+		ManaGainedAvg: manaGainedMetrics.ManaGained / float64(numIterations),
+		OverflowAvg:   manaGainedMetrics.Overflow / float64(numIterations),
 	}
 }
 
 func NewCharacterMetrics() CharacterMetrics {
 	return CharacterMetrics{
-		dps:     NewDistributionMetrics(),
-		threat:  NewDistributionMetrics(),
-		actions: make(map[ActionKey]ActionMetrics),
+		dps:        NewDistributionMetrics(),
+		threat:     NewDistributionMetrics(),
+		hps:        NewDistributionMetrics(),
+		actions:    make(map[ActionKey]ActionMetrics),
+		manaGained: make(map[ActionKey]ManaGainedMetrics),
 	}
 }
 
@@ -173,8 +222,12 @@ func (characterMetrics *CharacterMetrics) AddSpellCast(spellCast *SpellCast) {
 	actionMetrics.Misses += spellCast.Misses
 	actionMetrics.Crits += spellCast.Crits
 	actionMetrics.Damage += spellCast.TotalDamage
+	actionMetrics.Healing += spellCast.TotalHealing
+	actionMetrics.Overhealing += spellCast.TotalOverhealing
 	characterMetrics.dps.Total += spellCast.TotalDamage
 	characterMetrics.threat.Total += spellCast.TotalThreat
+	characterMetrics.hps.Total += spellCast.TotalHealing
+	characterMetrics.Overhealing += spellCast.TotalOverhealing
 
 	characterMetrics.actions[actionKey] = actionMetrics
 }
@@ -205,6 +258,22 @@ func (characterMetrics *CharacterMetrics) AddMeleeAbility(ability *ActiveMeleeAb
 	characterMetrics.actions[actionKey] = actionMetrics
 }
 
+// Records mana gained from the given source, and how much of it was wasted
+// because it would have gone above max mana.
+func (characterMetrics *CharacterMetrics) AddManaGained(actionID ActionID, manaGained float64, overflow float64) {
+	actionKey := NewActionKey(actionID)
+	manaGainedMetrics, ok := characterMetrics.manaGained[actionKey]
+
+	if !ok {
+		manaGainedMetrics.ActionID = actionID
+	}
+
+	manaGainedMetrics.ManaGained += manaGained
+	manaGainedMetrics.Overflow += overflow
+
+	characterMetrics.manaGained[actionKey] = manaGainedMetrics
+}
+
 // This should be called at the end of each iteration, to include metrics from Pets in
 // those of their owner.
 // Assumes that doneIteration() has already been called on the pet metrics.
@@ -217,9 +286,19 @@ func (characterMetrics *CharacterMetrics) MarkOOM(character *Character, dur time
 	characterMetrics.CharacterIterationMetrics.WentOOM = true
 }
 
+// Records when the agent started waiting for mana, so we know when it first
+// went OOM.
+func (characterMetrics *CharacterMetrics) markOOMStart(oomStart time.Duration) {
+	if !characterMetrics.CharacterIterationMetrics.hasTimeToOOM {
+		characterMetrics.CharacterIterationMetrics.TimeToOOM = oomStart
+		characterMetrics.CharacterIterationMetrics.hasTimeToOOM = true
+	}
+}
+
 func (characterMetrics *CharacterMetrics) reset() {
 	characterMetrics.dps.reset()
 	characterMetrics.threat.reset()
+	characterMetrics.hps.reset()
 	characterMetrics.CharacterIterationMetrics = CharacterIterationMetrics{}
 }
 
@@ -227,23 +306,104 @@ func (characterMetrics *CharacterMetrics) reset() {
 func (characterMetrics *CharacterMetrics) doneIteration(encounterDurationSeconds float64) {
 	characterMetrics.dps.doneIteration(encounterDurationSeconds)
 	characterMetrics.threat.doneIteration(encounterDurationSeconds)
+	characterMetrics.hps.doneIteration(encounterDurationSeconds)
 	characterMetrics.oomTimeSum += float64(characterMetrics.OOMTime.Seconds())
+	if characterMetrics.hasTimeToOOM {
+		characterMetrics.timeToOOMSum += characterMetrics.TimeToOOM.Seconds()
+	} else {
+		characterMetrics.timeToOOMSum += encounterDurationSeconds
+	}
+	characterMetrics.movingTimeSum += characterMetrics.MovingTime.Seconds()
+	characterMetrics.damageTakenSum += characterMetrics.DamageTaken
+	characterMetrics.healingReceivedSum += characterMetrics.HealingReceived
+	characterMetrics.overkillSum += characterMetrics.Overkill
+	characterMetrics.healingSum += characterMetrics.hps.Total
+	characterMetrics.overhealingSum += characterMetrics.Overhealing
+	characterMetrics.manaSpentSum += characterMetrics.ManaSpent
+	if characterMetrics.Died {
+		characterMetrics.numDeaths++
+	}
 }
 
 func (characterMetrics *CharacterMetrics) ToProto(numIterations int32) *proto.PlayerMetrics {
 	protoMetrics := &proto.PlayerMetrics{
-		Dps:           characterMetrics.dps.ToProto(numIterations),
-		Threat:        characterMetrics.threat.ToProto(numIterations),
-		SecondsOomAvg: characterMetrics.oomTimeSum / float64(numIterations),
+		Dps:              characterMetrics.dps.ToProto(numIterations),
+		Threat:           characterMetrics.threat.ToProto(numIterations),
+		SecondsOomAvg:    characterMetrics.oomTimeSum / float64(numIterations),
+		SecondsToOomAvg:  characterMetrics.timeToOOMSum / float64(numIterations),
+		SecondsMovingAvg: characterMetrics.movingTimeSum / float64(numIterations),
+
+		DamageTakenAvg:     characterMetrics.damageTakenSum / float64(numIterations),
+		HealingReceivedAvg: characterMetrics.healingReceivedSum / float64(numIterations),
+		OverkillAvg:        characterMetrics.overkillSum / float64(numIterations),
+		DeathChance:        float64(characterMetrics.numDeaths) / float64(numIterations),
+
+		Hps:            characterMetrics.hps.ToProto(numIterations),
+		HealingAvg:     characterMetrics.healingSum / float64(numIterations),
+		OverhealingAvg: characterMetrics.overhealingSum / float64(numIterations),
+	}
+	if characterMetrics.manaSpentSum > 0 {
+		protoMetrics.Hpm = characterMetrics.healingSum / characterMetrics.manaSpentSum
 	}
 
 	for _, action := range characterMetrics.actions {
 		protoMetrics.Actions = append(protoMetrics.Actions, action.ToProto())
 	}
+	for _, manaGained := range characterMetrics.manaGained {
+		protoMetrics.ManaGained = append(protoMetrics.ManaGained, manaGained.ToProto(numIterations))
+	}
 
 	return protoMetrics
 }
 
+// Identifies a source of damage taken by a target.
+type damageSourceKey struct {
+	raidIndex int32
+	actionKey ActionKey
+	school    stats.Stat
+}
+
+// The damage taken by a target from one action of one player, aggregated
+// across iterations.
+type DamageTakenMetrics struct {
+	SourceRaidIndex int32
+	ActionID        ActionID
+	School          stats.Stat
+
+	Hits   int32
+	Damage float64
+}
+
+func (damageTakenMetrics *DamageTakenMetrics) ToProto(numIterations int32) *proto.DamageTakenMetrics {
+	return &proto.DamageTakenMetrics{
+		SourceRaidIndex: damageTakenMetrics.SourceRaidIndex,
+		Id:              damageTakenMetrics.ActionID.ToProto(),
+		School:          SpellSchoolToProto(damageTakenMetrics.School),
+		Hits:            damageTakenMetrics.Hits,
+		DamageAvg:       damageTakenMetrics.Damage / float64(numIterations),
+	}
+}
+
+// Converts a spell school, represented by its spell power stat, to its proto
+// enum. Physical damage uses AttackPower.
+func SpellSchoolToProto(school stats.Stat) proto.SpellSchool {
+	switch school {
+	case stats.ArcaneSpellPower:
+		return proto.SpellSchool_SpellSchoolArcane
+	case stats.FireSpellPower:
+		return proto.SpellSchool_SpellSchoolFire
+	case stats.FrostSpellPower:
+		return proto.SpellSchool_SpellSchoolFrost
+	case stats.HolySpellPower:
+		return proto.SpellSchool_SpellSchoolHoly
+	case stats.NatureSpellPower:
+		return proto.SpellSchool_SpellSchoolNature
+	case stats.ShadowSpellPower:
+		return proto.SpellSchool_SpellSchoolShadow
+	}
+	return proto.SpellSchool_SpellSchoolPhysical
+}
+
 type AuraMetrics struct {
 	ID ActionID
 
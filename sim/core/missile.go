@@ -0,0 +1,96 @@
+package core
+
+import (
+	"time"
+)
+
+// Returns how long a missile with the given speed, in yards per second, takes
+// to travel the encounter distance. Returns 0 for spells without a missile.
+func (sim *Simulation) MissileTravelTime(missileSpeed float64) time.Duration {
+	if missileSpeed == 0 || sim.encounter.Distance == 0 {
+		return 0
+	}
+	return DurationFromSeconds(sim.encounter.Distance / missileSpeed)
+}
+
+// Applies this spell's effects once its missile lands. Spells without dots
+// don't need to be tracked after they land, so the missile carries its own
+// copy and the spell object can be recast right away. Spells with dots keep
+// their object in use until the missile lands.
+func (spell *SimpleSpell) launchMissile(sim *Simulation, travelTime time.Duration) {
+	missile := spell
+	if !spell.hasDots() {
+		missile = &SimpleSpell{}
+		*missile = *spell
+		if len(spell.Effects) > 0 {
+			missile.Effects = make([]SpellHitEffect, len(spell.Effects))
+			copy(missile.Effects, spell.Effects)
+		}
+		spell.objectInUse = false
+	}
+
+	pa := sim.pendingActionPool.Get()
+	pa.Name = "Missile"
+	pa.Priority = ActionPriorityDOT
+	pa.NextActionAt = sim.CurrentTime + travelTime
+	pa.OnAction = func(sim *Simulation) {
+		missile.currentMissileAction = nil
+		missile.applyEffects(sim)
+	}
+	pa.CleanUp = func(sim *Simulation) {
+		if pa.cancelled {
+			return
+		}
+		pa.cancelled = true
+		missile.currentMissileAction = nil
+
+		// The missile never landed, e.g. because the iteration ended first, so
+		// the spell does no damage.
+		if sim.Log != nil {
+			missile.Character.Log(sim, "%s missile did not land.", missile.ActionID)
+		}
+		missile.Character.Metrics.AddSpellCast(&missile.SpellCast)
+		missile.objectInUse = false
+	}
+
+	missile.currentMissileAction = pa
+	sim.AddPendingAction(pa)
+}
+
+func (spell *SimpleSpell) hasDots() bool {
+	if len(spell.Effects) == 0 {
+		return spell.Effect.DotInput.NumberOfTicks > 0
+	}
+	// This assumes that the effects either all have dots, or none of them do.
+	return spell.Effects[0].DotInput.NumberOfTicks > 0
+}
+
+// Performs this ability's attacks once its missile lands. Ability objects are
+// reused right away, e.g. for the next auto shot, so the missile carries its
+// own copy of the ability.
+func (ability *ActiveMeleeAbility) launchMissile(sim *Simulation, travelTime time.Duration) {
+	missile := *ability
+	if len(ability.Effects) > 0 {
+		missile.Effects = make([]AbilityHitEffect, len(ability.Effects))
+		copy(missile.Effects, ability.Effects)
+	}
+
+	landed := false
+	sim.AddPendingAction(&PendingAction{
+		Name:         "Missile",
+		Priority:     ActionPriorityDOT,
+		NextActionAt: sim.CurrentTime + travelTime,
+		OnAction: func(sim *Simulation) {
+			landed = true
+			missile.performAttacks(sim)
+			missile.Character.Metrics.AddMeleeAbility(&missile)
+		},
+		CleanUp: func(sim *Simulation) {
+			if landed {
+				return
+			}
+			landed = true
+			missile.Character.Metrics.AddMeleeAbility(&missile)
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestMissileTravelTime(t *testing.T) {
+	sim := &Simulation{
+		encounter: NewEncounter(proto.Encounter{
+			Duration: 60,
+			Distance: 30,
+			Targets:  []*proto.Target{{}},
+		}),
+	}
+
+	if travelTime := sim.MissileTravelTime(24); travelTime != time.Millisecond*1250 {
+		t.Fatalf("Expected 1.25s travel time, got %s", travelTime)
+	}
+	if travelTime := sim.MissileTravelTime(0); travelTime != 0 {
+		t.Fatalf("Expected spells without a missile to land instantly, got %s", travelTime)
+	}
+
+	sim.encounter.Distance = 0
+	if travelTime := sim.MissileTravelTime(24); travelTime != 0 {
+		t.Fatalf("Expected missiles to land instantly at 0 distance, got %s", travelTime)
+	}
+}
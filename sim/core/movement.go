@@ -0,0 +1,165 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// A recurring period during which all players must move, e.g. to dodge a boss
+// ability or to follow a boss across the room.
+type MovementEvent struct {
+	StartTime time.Duration
+	Duration  time.Duration
+
+	// If > 0, the movement repeats this long after each occurrence.
+	Interval time.Duration
+
+	// Each occurrence is randomly moved earlier or later by up to this much.
+	IntervalVariation time.Duration
+}
+
+func NewMovementEvent(options proto.MovementEvent) MovementEvent {
+	return MovementEvent{
+		StartTime:         DurationFromSeconds(options.StartTime),
+		Duration:          DurationFromSeconds(options.Duration),
+		Interval:          DurationFromSeconds(options.Interval),
+		IntervalVariation: DurationFromSeconds(options.IntervalVariation),
+	}
+}
+
+// Agents may implement this interface to choose what they cast while moving.
+// Agents which don't will wait for the movement to end instead.
+type MovingAgent interface {
+	// Called instead of OnGCDReady while the Agent is moving. Only instant
+	// casts may be used, and the Agent must either use the GCD or call
+	// WaitUntil().
+	OnGCDReadyWhileMoving(sim *Simulation)
+}
+
+// Schedules the first occurrence of each movement event. Should be called
+// after the raid is reset.
+func (encounter *Encounter) resetMovements(sim *Simulation) {
+	for _, event := range encounter.Movements {
+		encounter.scheduleMovement(sim, event, event.StartTime)
+	}
+}
+
+func (encounter *Encounter) scheduleMovement(sim *Simulation, event MovementEvent, nominalTime time.Duration) {
+	startTime := nominalTime
+	if event.IntervalVariation > 0 {
+		startTime += time.Duration(sim.RandomFloat("Movement")*float64(event.IntervalVariation*2)) - event.IntervalVariation
+	}
+	startTime = MaxDuration(startTime, sim.CurrentTime)
+
+	if startTime > sim.Duration {
+		return
+	}
+
+	sim.AddPendingAction(&PendingAction{
+		Name:         "Movement",
+		Priority:     ActionPriorityPhase,
+		NextActionAt: startTime,
+		OnAction: func(sim *Simulation) {
+			movingUntil := sim.CurrentTime + event.Duration
+			for _, party := range sim.Raid.Parties {
+				for _, agent := range party.Players {
+					character := agent.GetCharacter()
+					character.StartMoving(sim, movingUntil)
+
+					// Pets follow their owner. Pets summoned during the movement
+					// start out in place.
+					for _, petAgent := range character.Pets {
+						if pet := petAgent.GetPet(); pet.IsEnabled() {
+							pet.StartMoving(sim, movingUntil)
+						}
+					}
+				}
+			}
+
+			if event.Interval > 0 {
+				encounter.scheduleMovement(sim, event, nominalTime+event.Interval)
+			}
+		},
+	})
+}
+
+func (character *Character) IsMoving(sim *Simulation) bool {
+	return character.movingUntil > sim.CurrentTime
+}
+
+// Returns the time at which the current movement ends. Only valid when
+// IsMoving() is true.
+func (character *Character) MovementEndsAt() time.Duration {
+	return character.movingUntil
+}
+
+// Makes this character move until the given time. Any hardcast in progress is
+// interrupted, and auto attacks are delayed until the movement ends.
+func (character *Character) StartMoving(sim *Simulation, until time.Duration) {
+	if until <= character.movingUntil {
+		return
+	}
+
+	// Only count time not already covered by an overlapping movement.
+	movingFrom := MaxDuration(sim.CurrentTime, character.movingUntil)
+	character.Metrics.MovingTime += MaxDuration(0, MinDuration(until, sim.Duration)-movingFrom)
+	character.movingUntil = until
+
+	if sim.Log != nil {
+		character.Log(sim, "Moving for %s.", until-sim.CurrentTime)
+	}
+
+	character.interruptHardcast(sim)
+
+	if character.IsWaitingForMana() {
+		character.Metrics.MarkOOM(character, sim.CurrentTime-character.waitStartTime)
+		character.waitStartTime = 0
+		character.waitingForMana = 0
+	}
+
+	if character.AutoAttacks.IsEnabled() {
+		character.AutoAttacks.DelayAllUntil(sim, until)
+	}
+
+	// Wake up the agent so it can decide what to do while moving, even if it
+	// was idle.
+	if character.gcdAction != nil {
+		character.SetGCDTimer(sim, MaxDuration(sim.CurrentTime, character.NextGCDAt()))
+	}
+}
+
+func (character *Character) interruptHardcast(sim *Simulation) {
+	if character.Hardcast.Expires <= sim.CurrentTime {
+		return
+	}
+
+	cast := character.Hardcast.Cast
+	castStartTime := character.Hardcast.Expires - cast.CastTime
+	if sim.Log != nil {
+		character.Log(sim, "Interrupted casting %s.", cast.ActionID)
+	}
+
+	cast.Cancel()
+	character.Hardcast = Hardcast{}
+	character.hardcastAction.Cancel(sim)
+	character.hardcastAction = character.newHardcastAction(sim)
+	character.AutoAttacks.RangedSwingInProgress = false
+
+	// The spell never went off, so it doesn't go on cooldown, and only the
+	// GCD from the start of the cast remains.
+	if cast.Cooldown > 0 {
+		character.SetCD(cast.ActionID.CooldownID, sim.CurrentTime)
+	}
+	if cast.GCD != 0 {
+		character.SetGCDTimer(sim, MaxDuration(sim.CurrentTime, castStartTime+cast.CalculatedGCD(character)))
+	}
+}
+
+func (character *Character) onGCDReadyWhileMoving(sim *Simulation, agent Agent) {
+	if movingAgent, ok := agent.(MovingAgent); ok {
+		movingAgent.OnGCDReadyWhileMoving(sim)
+	} else {
+		character.WaitUntil(sim, character.movingUntil)
+	}
+}
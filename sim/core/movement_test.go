@@ -0,0 +1,56 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestMovementScheduling(t *testing.T) {
+	encounter := NewEncounter(proto.Encounter{
+		Duration:  60,
+		Targets:   []*proto.Target{{}},
+		Movements: []*proto.MovementEvent{{StartTime: 10, Duration: 3, Interval: 20}},
+	})
+	sim := &Simulation{
+		encounter: encounter,
+		Duration:  time.Second * 60,
+	}
+
+	sim.encounter.resetMovements(sim)
+	if len(sim.pendingActions) != 1 || sim.pendingActions[0].NextActionAt != time.Second*10 {
+		t.Fatalf("Expected the first movement to be scheduled at 10s")
+	}
+}
+
+func TestTimeMoving(t *testing.T) {
+	sim := &Simulation{
+		Duration: time.Second * 60,
+	}
+	character := &Character{}
+
+	character.StartMoving(sim, time.Second*5)
+	if !character.IsMoving(sim) || character.MovementEndsAt() != time.Second*5 {
+		t.Fatalf("Expected character to be moving until 5s")
+	}
+
+	// Overlapping movement only counts the extra time.
+	sim.CurrentTime = time.Second * 3
+	character.StartMoving(sim, time.Second*8)
+	if character.Metrics.MovingTime != time.Second*8 {
+		t.Fatalf("Expected 8s moving, got %s", character.Metrics.MovingTime)
+	}
+
+	sim.CurrentTime = time.Second * 8
+	if character.IsMoving(sim) {
+		t.Fatalf("Expected movement to end at 8s")
+	}
+
+	// Movement past the end of the iteration isn't counted.
+	sim.CurrentTime = time.Second * 58
+	character.StartMoving(sim, time.Second*63)
+	if character.Metrics.MovingTime != time.Second*10 {
+		t.Fatalf("Expected 10s moving, got %s", character.Metrics.MovingTime)
+	}
+}
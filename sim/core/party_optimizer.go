@@ -0,0 +1,418 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const maxParties = 5
+const maxPartySize = 5
+
+// Special values for slots in a raidLayout which don't hold a player.
+const (
+	slotEmpty   = -1
+	slotBuffBot = -2
+)
+
+// Raid index, in the input raid, of the player in each slot.
+type raidLayout [maxParties][maxPartySize]int32
+
+// Searches for the party assignment with the highest raid DPS. Starts from
+// the better of the input layout and a greedy layout, then tries swapping
+// players between parties until no swap improves DPS.
+type partyOptimizer struct {
+	request    *proto.PartyOptimizerRequest
+	simOptions *proto.SimOptions
+
+	// Players from the input raid, by raid index.
+	players map[int32]*proto.Player
+	fixed   map[int32]bool
+
+	maxPartySize int
+	numSims      int32
+}
+
+func optimizeParties(request *proto.PartyOptimizerRequest) *proto.PartyOptimizerResult {
+	result, err := newPartyOptimizer(request).optimize()
+	if err != nil {
+		return &proto.PartyOptimizerResult{
+			ErrorResult: err.Error(),
+		}
+	}
+	return result
+}
+
+func (optimizer *partyOptimizer) optimize() (*proto.PartyOptimizerResult, error) {
+	inputLayout, err := optimizer.inputLayout()
+	if err != nil {
+		return nil, err
+	}
+	// Every sim shares the encounter, items and players, so a bad request fails here.
+	inputDps, err := optimizer.evaluate(inputLayout)
+	if err != nil {
+		return nil, err
+	}
+
+	bestLayout, bestDps, err := optimizer.greedyLayout()
+	if err != nil {
+		return nil, err
+	}
+	if optimizer.isValid(inputLayout) && inputDps >= bestDps {
+		bestLayout = inputLayout
+		bestDps = inputDps
+	}
+
+	bestLayout, bestDps, err = optimizer.swapPlayers(bestLayout, bestDps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.PartyOptimizerResult{
+		Raid:     optimizer.buildRaid(bestLayout),
+		Dps:      bestDps,
+		InputDps: inputDps,
+		DpsGain:  bestDps - inputDps,
+		NumSims:  optimizer.numSims,
+	}, nil
+}
+
+func newPartyOptimizer(request *proto.PartyOptimizerRequest) *partyOptimizer {
+	optimizer := &partyOptimizer{
+		request:      request,
+		simOptions:   optimizerSimOptions(request.SimOptions),
+		players:      make(map[int32]*proto.Player),
+		fixed:        make(map[int32]bool),
+		maxPartySize: maxPartySize,
+	}
+	if request.MaxPartySize > 0 && request.MaxPartySize < maxPartySize {
+		optimizer.maxPartySize = int(request.MaxPartySize)
+	}
+
+	for partyIdx, party := range request.Raid.Parties {
+		if partyIdx >= maxParties || party == nil {
+			continue
+		}
+		for playerIdx, player := range party.Players {
+			if playerIdx >= maxPartySize || player == nil || player.Class == proto.Class_ClassUnknown {
+				continue
+			}
+			optimizer.players[int32(partyIdx*maxPartySize+playerIdx)] = player
+		}
+	}
+	for _, raidIndex := range request.FixedRaidIndices {
+		optimizer.fixed[raidIndex] = true
+	}
+
+	return optimizer
+}
+
+// Returns a copy of simOptions suitable for comparing many sims against each other.
+func optimizerSimOptions(simOptions *proto.SimOptions) *proto.SimOptions {
+	options := &proto.SimOptions{}
+	if simOptions != nil {
+		options = googleProto.Clone(simOptions).(*proto.SimOptions)
+	}
+	options.Debug = false
+	options.DebugFirstIteration = false
+	if options.RandomSeed == 0 {
+		// Use the same random numbers for every sim, so differences in DPS
+		// come from the inputs rather than from noise.
+		options.RandomSeed = 1
+	}
+	return options
+}
+
+func (optimizer *partyOptimizer) inputLayout() (raidLayout, error) {
+	layout, err := optimizer.emptyLayout()
+	if err != nil {
+		return layout, err
+	}
+	for raidIndex := range optimizer.players {
+		if layout[raidIndex/maxPartySize][raidIndex%maxPartySize] == slotBuffBot {
+			return layout, fmt.Errorf("buff bot slot %d is taken by a player", raidIndex)
+		}
+		layout[raidIndex/maxPartySize][raidIndex%maxPartySize] = raidIndex
+	}
+	return layout, nil
+}
+
+// Returns a layout with only the buff bots.
+func (optimizer *partyOptimizer) emptyLayout() (raidLayout, error) {
+	layout := raidLayout{}
+	for partyIdx := range layout {
+		for slotIdx := range layout[partyIdx] {
+			layout[partyIdx][slotIdx] = slotEmpty
+		}
+	}
+	for _, raidIndex := range optimizer.request.BuffBotRaidIndices {
+		if raidIndex < 0 || raidIndex >= maxParties*maxPartySize {
+			return layout, fmt.Errorf("invalid buff bot raid index: %d", raidIndex)
+		}
+		layout[raidIndex/maxPartySize][raidIndex%maxPartySize] = slotBuffBot
+	}
+	return layout, nil
+}
+
+// Whether the layout respects the max party size.
+func (optimizer *partyOptimizer) isValid(layout raidLayout) bool {
+	for partyIdx := range layout {
+		if layout.partySize(partyIdx) > optimizer.maxPartySize {
+			return false
+		}
+	}
+	return true
+}
+
+func (layout *raidLayout) partySize(partyIdx int) int {
+	size := 0
+	for _, slot := range layout[partyIdx] {
+		if slot != slotEmpty {
+			size++
+		}
+	}
+	return size
+}
+
+// Returns the first empty slot in the party, or -1 if there is none.
+func (layout *raidLayout) firstEmptySlot(partyIdx int) int {
+	for slotIdx, slot := range layout[partyIdx] {
+		if slot == slotEmpty {
+			return slotIdx
+		}
+	}
+	return -1
+}
+
+func (optimizer *partyOptimizer) canAddToParty(layout *raidLayout, partyIdx int) bool {
+	return layout.partySize(partyIdx) < optimizer.maxPartySize && layout.firstEmptySlot(partyIdx) != -1
+}
+
+func (optimizer *partyOptimizer) isMovable(slot int32) bool {
+	return slot >= 0 && !optimizer.fixed[slot]
+}
+
+// Places fixed players first, then adds each other player to whichever party
+// gives the highest DPS so far.
+func (optimizer *partyOptimizer) greedyLayout() (raidLayout, float64, error) {
+	layout, err := optimizer.emptyLayout()
+	if err != nil {
+		return layout, 0, err
+	}
+	movablePlayers := []int32{}
+	for raidIndex := int32(0); raidIndex < maxParties*maxPartySize; raidIndex++ {
+		if _, ok := optimizer.players[raidIndex]; !ok {
+			continue
+		}
+		if optimizer.fixed[raidIndex] {
+			layout[raidIndex/maxPartySize][raidIndex%maxPartySize] = raidIndex
+		} else {
+			movablePlayers = append(movablePlayers, raidIndex)
+		}
+	}
+
+	if len(movablePlayers) == 0 {
+		dps, err := optimizer.evaluate(layout)
+		return layout, dps, err
+	}
+
+	dps := 0.0
+	for _, raidIndex := range movablePlayers {
+		bestPartyIdx := -1
+		bestDps := 0.0
+		for partyIdx := 0; partyIdx < maxParties; partyIdx++ {
+			if !optimizer.canAddToParty(&layout, partyIdx) || optimizer.isDuplicateEmptyParty(&layout, partyIdx) {
+				continue
+			}
+
+			candidate := layout
+			candidate[partyIdx][candidate.firstEmptySlot(partyIdx)] = raidIndex
+			candidateDps, err := optimizer.evaluate(candidate)
+			if err != nil {
+				return layout, 0, err
+			}
+			if bestPartyIdx == -1 || candidateDps > bestDps {
+				bestPartyIdx = partyIdx
+				bestDps = candidateDps
+			}
+		}
+
+		if bestPartyIdx == -1 {
+			return layout, 0, fmt.Errorf("not enough room in the raid for all players")
+		}
+		layout[bestPartyIdx][layout.firstEmptySlot(bestPartyIdx)] = raidIndex
+		dps = bestDps
+	}
+
+	return layout, dps, nil
+}
+
+// Whether the party is empty and an earlier party is also empty with the same
+// party buffs, in which case adding a player to either gives the same result.
+func (optimizer *partyOptimizer) isDuplicateEmptyParty(layout *raidLayout, partyIdx int) bool {
+	if layout.partySize(partyIdx) != 0 {
+		return false
+	}
+	for otherIdx := 0; otherIdx < partyIdx; otherIdx++ {
+		if layout.partySize(otherIdx) == 0 && googleProto.Equal(optimizer.partyBuffs(otherIdx), optimizer.partyBuffs(partyIdx)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (optimizer *partyOptimizer) partyBuffs(partyIdx int) *proto.PartyBuffs {
+	parties := optimizer.request.Raid.Parties
+	if partyIdx < len(parties) && parties[partyIdx] != nil && parties[partyIdx].Buffs != nil {
+		return parties[partyIdx].Buffs
+	}
+	return &proto.PartyBuffs{}
+}
+
+// Tries swapping each movable player with each player or empty slot in
+// another party, keeping any swap which improves DPS.
+func (optimizer *partyOptimizer) swapPlayers(layout raidLayout, dps float64) (raidLayout, float64, error) {
+	maxPasses := 3
+	if optimizer.request.MaxSwapPasses > 0 {
+		maxPasses = int(optimizer.request.MaxSwapPasses)
+	}
+
+	for pass := 0; pass < maxPasses; pass++ {
+		improved := false
+
+		for partyA := 0; partyA < maxParties; partyA++ {
+			for slotA := 0; slotA < maxPartySize; slotA++ {
+				if !optimizer.isMovable(layout[partyA][slotA]) {
+					continue
+				}
+
+				for partyB := 0; partyB < maxParties; partyB++ {
+					if partyB == partyA {
+						continue
+					}
+
+					for slotB := 0; slotB < maxPartySize; slotB++ {
+						slot := layout[partyB][slotB]
+						if slot == slotEmpty {
+							// All empty slots in a party are equivalent, so only try the first one.
+							if slotB != layout.firstEmptySlot(partyB) || !optimizer.canAddToParty(&layout, partyB) {
+								continue
+							}
+						} else if !optimizer.isMovable(slot) || partyB < partyA {
+							// Swaps between two players were already tried from the other side.
+							continue
+						}
+
+						candidate := layout
+						candidate[partyA][slotA], candidate[partyB][slotB] = candidate[partyB][slotB], candidate[partyA][slotA]
+						candidateDps, err := optimizer.evaluate(candidate)
+						if err != nil {
+							return layout, dps, err
+						}
+						if candidateDps > dps {
+							layout = candidate
+							dps = candidateDps
+							improved = true
+						}
+
+						if !optimizer.isMovable(layout[partyA][slotA]) {
+							// The player in slot A was moved, so move on to the next slot.
+							break
+						}
+					}
+					if !optimizer.isMovable(layout[partyA][slotA]) {
+						break
+					}
+				}
+			}
+		}
+
+		if !improved {
+			break
+		}
+	}
+
+	return layout, dps, nil
+}
+
+// Runs a raid sim with the given layout and returns the average raid DPS.
+func (optimizer *partyOptimizer) evaluate(layout raidLayout) (float64, error) {
+	optimizer.numSims++
+	result := RunRaidSim(&proto.RaidSimRequest{
+		Raid:            optimizer.buildRaid(layout),
+		Encounter:       optimizer.request.Encounter,
+		SimOptions:      optimizer.simOptions,
+		CustomItems:     optimizer.request.CustomItems,
+		PresetEncounter: optimizer.request.PresetEncounter,
+	})
+	if result.ErrorResult != "" {
+		return 0, errors.New(result.ErrorResult)
+	}
+	return result.RaidMetrics.Dps.Avg, nil
+}
+
+func (optimizer *partyOptimizer) buildRaid(layout raidLayout) *proto.Raid {
+	newRaidIndices := make(map[int32]int32)
+	for partyIdx := range layout {
+		for slotIdx, slot := range layout[partyIdx] {
+			if slot >= 0 {
+				newRaidIndices[slot] = int32(partyIdx*maxPartySize + slotIdx)
+			}
+		}
+	}
+
+	raid := &proto.Raid{
+		Buffs: optimizer.request.Raid.Buffs,
+	}
+	for partyIdx := range layout {
+		party := &proto.Party{}
+		if partyIdx < len(optimizer.request.Raid.Parties) && optimizer.request.Raid.Parties[partyIdx] != nil {
+			party.Buffs = optimizer.request.Raid.Parties[partyIdx].Buffs
+		}
+
+		for _, slot := range layout[partyIdx] {
+			if slot < 0 {
+				party.Players = append(party.Players, &proto.Player{})
+				continue
+			}
+
+			player := googleProto.Clone(optimizer.players[slot]).(*proto.Player)
+			remapRaidTargets(player.ProtoReflect(), newRaidIndices)
+			party.Players = append(party.Players, player)
+		}
+
+		raid.Parties = append(raid.Parties, party)
+	}
+
+	return raid
+}
+
+// Updates all RaidTargets within the message to point to the new raid index
+// of the player they pointed to.
+func remapRaidTargets(message protoreflect.Message, newRaidIndices map[int32]int32) {
+	if raidTarget, ok := message.Interface().(*proto.RaidTarget); ok {
+		if newIndex, ok := newRaidIndices[raidTarget.TargetIndex]; ok {
+			raidTarget.TargetIndex = newIndex
+		}
+		return
+	}
+
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.Message() == nil || field.IsMap() {
+			return true
+		}
+
+		if field.IsList() {
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				remapRaidTargets(list.Get(i).Message(), newRaidIndices)
+			}
+		} else {
+			remapRaidTargets(value.Message(), newRaidIndices)
+		}
+		return true
+	})
+}
@@ -18,6 +18,10 @@ const (
 
 	// DOTs need to be higher than anything else so that dots can properly expire before we take other actions.
 	ActionPriorityDOT = 3
+
+	// Encounter phases run before everything else at the same timestamp, so
+	// that agents always see the new phase.
+	ActionPriorityPhase = 10
 )
 
 type PendingAction struct {
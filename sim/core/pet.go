@@ -46,15 +46,16 @@ type Pet struct {
 func NewPet(name string, owner *Character, baseStats stats.Stats, statInheritance PetStatInheritance, enabledOnStart bool) Pet {
 	pet := Pet{
 		Character: Character{
-			Name:        name,
-			Label:       fmt.Sprintf("%s - %s", owner.Label, name),
-			PseudoStats: stats.NewPseudoStats(),
-			Party:       owner.Party,
-			PartyIndex:  owner.PartyIndex,
-			RaidIndex:   owner.RaidIndex,
-			auraTracker: newAuraTracker(false),
-			baseStats:   baseStats,
-			Metrics:     NewCharacterMetrics(),
+			Name:           name,
+			Label:          fmt.Sprintf("%s - %s", owner.Label, name),
+			PseudoStats:    stats.NewPseudoStats(),
+			Party:          owner.Party,
+			PartyIndex:     owner.PartyIndex,
+			RaidIndex:      owner.RaidIndex,
+			assignedTarget: owner.assignedTarget,
+			auraTracker:    newAuraTracker(false),
+			baseStats:      baseStats,
+			Metrics:        NewCharacterMetrics(),
 		},
 		Owner:           owner,
 		statInheritance: statInheritance,
@@ -0,0 +1,349 @@
+// Package profile imports and exports characters in a simple text format, so
+// sim profiles can be built from addon/armory-style exports instead of
+// clicking through the UI.
+//
+// Example:
+//
+//	# Lines starting with '#' and blank lines are ignored.
+//	name: Thrall
+//	race: Orc
+//	class: Shaman
+//	spec: EnhancementShaman
+//	talents: 250030502-502500210501133531151
+//	professions: Enchanting, Engineering
+//	head: 32235 enchant=29192 gems=32409,24027
+//	neck: 29381
+//	mainhand: 32262 enchant=22559
+//
+// Item lines use the ItemSlot names without the prefix (head, neck, shoulder,
+// back, chest, wrist, hands, waist, legs, feet, finger1, finger2, trinket1,
+// trinket2, mainhand, offhand, ranged). Empty sockets are written as gem 0.
+package profile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/talents"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var specClasses = map[proto.Spec]proto.Class{
+	proto.Spec_SpecBalanceDruid:       proto.Class_ClassDruid,
+	proto.Spec_SpecElementalShaman:    proto.Class_ClassShaman,
+	proto.Spec_SpecEnhancementShaman:  proto.Class_ClassShaman,
+	proto.Spec_SpecHolyPaladin:        proto.Class_ClassPaladin,
+	proto.Spec_SpecHolyPriest:         proto.Class_ClassPriest,
+	proto.Spec_SpecHunter:             proto.Class_ClassHunter,
+	proto.Spec_SpecMage:               proto.Class_ClassMage,
+	proto.Spec_SpecRestorationDruid:   proto.Class_ClassDruid,
+	proto.Spec_SpecRestorationShaman:  proto.Class_ClassShaman,
+	proto.Spec_SpecRetributionPaladin: proto.Class_ClassPaladin,
+	proto.Spec_SpecRogue:              proto.Class_ClassRogue,
+	proto.Spec_SpecShadowPriest:       proto.Class_ClassPriest,
+	proto.Spec_SpecSmitePriest:        proto.Class_ClassPriest,
+	proto.Spec_SpecWarlock:            proto.Class_ClassWarlock,
+	proto.Spec_SpecWarrior:            proto.Class_ClassWarrior,
+}
+
+// Parses a text profile into settings for an individual sim. Item, gem, and
+// enchant IDs are checked against db; unknown IDs are dropped and reported in
+// the returned warnings. An error is returned if the profile can't be parsed
+// at all.
+func Import(text string, db *items.Database) (*proto.IndividualSimSettings, []string, error) {
+	player := &proto.Player{
+		Equipment: &proto.EquipmentSpec{
+			Items: make([]*proto.ItemSpec, items.ItemSlotRanged+1),
+		},
+	}
+	for i := range player.Equipment.Items {
+		player.Equipment.Items[i] = &proto.ItemSpec{}
+	}
+
+	var warnings []string
+	spec := proto.Spec(-1)
+
+	for lineIdx, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		colonIdx := strings.Index(line, ":")
+		if colonIdx == -1 {
+			return nil, nil, fmt.Errorf("line %d: expected 'key: value', got %q", lineIdx+1, line)
+		}
+		key := strings.ToLower(strings.TrimSpace(line[:colonIdx]))
+		value := strings.TrimSpace(line[colonIdx+1:])
+
+		var err error
+		switch key {
+		case "name":
+			player.Name = value
+		case "race":
+			var race int32
+			race, err = parseEnum("race", proto.Race_value, "Race", value)
+			player.Race = proto.Race(race)
+		case "class":
+			var class int32
+			class, err = parseEnum("class", proto.Class_value, "Class", value)
+			player.Class = proto.Class(class)
+		case "spec":
+			var specValue int32
+			specValue, err = parseEnum("spec", proto.Spec_value, "Spec", value)
+			spec = proto.Spec(specValue)
+		case "talents":
+			player.TalentsString = value
+		case "professions":
+			for _, professionStr := range strings.Split(value, ",") {
+				if strings.TrimSpace(professionStr) == "" {
+					continue
+				}
+				var profession int32
+				profession, err = parseEnum("profession", proto.Profession_value, "Profession", professionStr)
+				if err != nil {
+					break
+				}
+				player.Professions = append(player.Professions, proto.Profession(profession))
+			}
+		default:
+			slot, ok := parseSlot(key)
+			if !ok {
+				return nil, nil, fmt.Errorf("line %d: unknown key %q", lineIdx+1, key)
+			}
+			var itemSpec *proto.ItemSpec
+			var itemWarnings []string
+			itemSpec, itemWarnings, err = parseItem(value, slot, db)
+			if err == nil {
+				player.Equipment.Items[slot] = itemSpec
+				warnings = append(warnings, itemWarnings...)
+			}
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %s", lineIdx+1, err)
+		}
+	}
+
+	if player.Class == proto.Class_ClassUnknown {
+		return nil, nil, fmt.Errorf("profile has no class")
+	}
+	if spec == -1 {
+		for s, class := range specClasses {
+			if class != player.Class {
+				continue
+			}
+			if spec != -1 {
+				return nil, nil, fmt.Errorf("profile has no spec, and %s has more than one", player.Class)
+			}
+			spec = s
+		}
+	}
+	if specClasses[spec] != player.Class {
+		return nil, nil, fmt.Errorf("spec %s is not a %s spec", spec, player.Class)
+	}
+	setSpec(player, spec)
+
+	if err := talents.ApplyTalentsString(player); err != nil {
+		return nil, nil, err
+	}
+
+	return &proto.IndividualSimSettings{
+		Player: player,
+	}, warnings, nil
+}
+
+// Formats a player as a text profile. This is the reverse of Import().
+func Export(player *proto.Player) (string, error) {
+	spec, ok := getSpec(player)
+	if !ok {
+		return "", fmt.Errorf("player has no spec")
+	}
+
+	talentsString := player.TalentsString
+	if talentsString == "" {
+		var err error
+		talentsString, err = talents.GetTalentsString(player)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var sb strings.Builder
+	if player.Name != "" {
+		sb.WriteString(fmt.Sprintf("name: %s\n", player.Name))
+	}
+	sb.WriteString(fmt.Sprintf("race: %s\n", strings.TrimPrefix(player.Race.String(), "Race")))
+	sb.WriteString(fmt.Sprintf("class: %s\n", strings.TrimPrefix(player.Class.String(), "Class")))
+	sb.WriteString(fmt.Sprintf("spec: %s\n", strings.TrimPrefix(spec.String(), "Spec")))
+	if talentsString != "" {
+		sb.WriteString(fmt.Sprintf("talents: %s\n", talentsString))
+	}
+	if len(player.Professions) > 0 {
+		professionStrs := make([]string, len(player.Professions))
+		for i, profession := range player.Professions {
+			professionStrs[i] = strings.TrimPrefix(profession.String(), "Profession")
+		}
+		sb.WriteString(fmt.Sprintf("professions: %s\n", strings.Join(professionStrs, ", ")))
+	}
+
+	if player.Equipment != nil {
+		for i, itemSpec := range player.Equipment.Items {
+			if itemSpec == nil || itemSpec.Id == 0 {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("%s: %d", slotName(proto.ItemSlot(i)), itemSpec.Id))
+			if itemSpec.Enchant != 0 {
+				sb.WriteString(fmt.Sprintf(" enchant=%d", itemSpec.Enchant))
+			}
+			if len(itemSpec.Gems) > 0 {
+				gemStrs := make([]string, len(itemSpec.Gems))
+				for j, gem := range itemSpec.Gems {
+					gemStrs[j] = strconv.Itoa(int(gem))
+				}
+				sb.WriteString(fmt.Sprintf(" gems=%s", strings.Join(gemStrs, ",")))
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// Parses the value of an item line, e.g. "32235 enchant=29192 gems=32409,24027".
+func parseItem(value string, slot proto.ItemSlot, db *items.Database) (*proto.ItemSpec, []string, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return nil, nil, fmt.Errorf("missing item ID")
+	}
+
+	id, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid item ID %q", fields[0])
+	}
+
+	var warnings []string
+	itemSpec := &proto.ItemSpec{}
+	if _, ok := db.ByID[int32(id)]; ok {
+		itemSpec.Id = int32(id)
+	} else {
+		warnings = append(warnings, fmt.Sprintf("Unknown item ID %d in %s slot.", id, slotName(slot)))
+		return itemSpec, warnings, nil
+	}
+
+	for _, field := range fields[1:] {
+		eqIdx := strings.Index(field, "=")
+		if eqIdx == -1 {
+			return nil, nil, fmt.Errorf("expected 'key=value', got %q", field)
+		}
+		fieldKey := strings.ToLower(field[:eqIdx])
+		fieldValue := field[eqIdx+1:]
+
+		switch fieldKey {
+		case "enchant":
+			enchantID, err := strconv.Atoi(fieldValue)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid enchant ID %q", fieldValue)
+			}
+			if enchant, ok := findEnchant(db, int32(enchantID)); ok {
+				itemSpec.Enchant = enchant.ID
+			} else {
+				warnings = append(warnings, fmt.Sprintf("Unknown enchant ID %d in %s slot.", enchantID, slotName(slot)))
+			}
+		case "gems":
+			for _, gemStr := range strings.Split(fieldValue, ",") {
+				gemID, err := strconv.Atoi(gemStr)
+				if err != nil {
+					return nil, nil, fmt.Errorf("invalid gem ID %q", gemStr)
+				}
+				if _, ok := db.GemsByID[int32(gemID)]; !ok && gemID != 0 {
+					warnings = append(warnings, fmt.Sprintf("Unknown gem ID %d in %s slot.", gemID, slotName(slot)))
+					gemID = 0
+				}
+				itemSpec.Gems = append(itemSpec.Gems, int32(gemID))
+			}
+		default:
+			return nil, nil, fmt.Errorf("unknown item field %q", fieldKey)
+		}
+	}
+
+	return itemSpec, warnings, nil
+}
+
+// Addons usually export the enchant effect ID rather than the ID of the
+// enchant item/spell, so accept either.
+func findEnchant(db *items.Database, id int32) (items.Enchant, bool) {
+	if enchant, ok := db.EnchantsByID[id]; ok {
+		return enchant, true
+	}
+	for _, enchant := range db.Enchants {
+		if enchant.EffectID == id {
+			return enchant, true
+		}
+	}
+	return items.Enchant{}, false
+}
+
+// Looks up an enum value by name, ignoring case, spaces, and the given prefix,
+// so e.g. "Night Elf" matches RaceNightElf.
+func parseEnum(kind string, values map[string]int32, prefix string, name string) (int32, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), " ", ""))
+	for enumName, value := range values {
+		if strings.ToLower(strings.TrimPrefix(enumName, prefix)) == normalized {
+			return value, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown %s %q", kind, name)
+}
+
+func parseSlot(name string) (proto.ItemSlot, bool) {
+	for slot := proto.ItemSlot(0); slot <= proto.ItemSlot_ItemSlotRanged; slot++ {
+		if slotName(slot) == name {
+			return slot, true
+		}
+	}
+	return 0, false
+}
+
+func slotName(slot proto.ItemSlot) string {
+	return strings.ToLower(strings.TrimPrefix(slot.String(), "ItemSlot"))
+}
+
+// Sets the player's spec oneof to an empty proto for the given spec, e.g.
+// SpecMage sets player.Spec to &proto.Player_Mage{Mage: &proto.Mage{}}.
+func setSpec(player *proto.Player, spec proto.Spec) {
+	playerMsg := player.ProtoReflect()
+	specFields := playerMsg.Descriptor().Oneofs().ByName("spec").Fields()
+	for i := 0; i < specFields.Len(); i++ {
+		if specFieldToSpec(specFields.Get(i).Name()) == spec {
+			playerMsg.Mutable(specFields.Get(i))
+			return
+		}
+	}
+	panic("No spec field for " + spec.String())
+}
+
+func getSpec(player *proto.Player) (proto.Spec, bool) {
+	playerMsg := player.ProtoReflect()
+	specField := playerMsg.WhichOneof(playerMsg.Descriptor().Oneofs().ByName("spec"))
+	if specField == nil {
+		return 0, false
+	}
+	spec := specFieldToSpec(specField.Name())
+	return spec, spec != -1
+}
+
+// Converts the name of a spec oneof field to its Spec, e.g. balance_druid to
+// SpecBalanceDruid.
+func specFieldToSpec(fieldName protoreflect.Name) proto.Spec {
+	words := strings.Split(string(fieldName), "_")
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	if spec, ok := proto.Spec_value["Spec"+strings.Join(words, "")]; ok {
+		return proto.Spec(spec)
+	}
+	return -1
+}
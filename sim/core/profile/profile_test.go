@@ -0,0 +1,107 @@
+package profile
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func testDatabase(t *testing.T) *items.Database {
+	db, err := items.NewDatabase(
+		[]items.Item{
+			{ID: 1, Name: "Test Helm", Type: proto.ItemType_ItemTypeHead, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorRed}},
+			{ID: 2, Name: "Test Axe", Type: proto.ItemType_ItemTypeWeapon},
+		},
+		[]items.Gem{
+			{ID: 10, Name: "Test Gem", Color: proto.GemColor_GemColorRed},
+		},
+		[]items.Enchant{
+			{ID: 100, EffectID: 1000, Name: "Test Enchant", ItemType: proto.ItemType_ItemTypeHead},
+		})
+	if err != nil {
+		t.Fatalf("Failed to create database: %s", err)
+	}
+	return db
+}
+
+const testProfile = `name: Test
+race: Orc
+class: Shaman
+spec: EnhancementShaman
+talents: 250030502-502500210501133531151
+professions: Enchanting, Engineering
+head: 1 enchant=100 gems=0,10
+mainhand: 2
+`
+
+func TestImportExportRoundTrip(t *testing.T) {
+	settings, warnings, err := Import(testProfile, testDatabase(t))
+	if err != nil {
+		t.Fatalf("Import failed: %s", err)
+	}
+	if len(warnings) != 0 {
+		t.Fatalf("Unexpected warnings: %v", warnings)
+	}
+
+	player := settings.Player
+	if player.Race != proto.Race_RaceOrc || player.Class != proto.Class_ClassShaman {
+		t.Fatalf("Wrong race/class: %s %s", player.Race, player.Class)
+	}
+	enh := player.GetEnhancementShaman()
+	if enh == nil {
+		t.Fatalf("Expected enhancement shaman spec")
+	}
+	if enh.Talents.Concussion != 5 || enh.Talents.Flurry != 5 || enh.Talents.ImprovedWeaponTotems != 1 || !enh.Talents.Stormstrike {
+		t.Fatalf("Talents were not decoded correctly: %v", enh.Talents)
+	}
+
+	exported, err := Export(player)
+	if err != nil {
+		t.Fatalf("Export failed: %s", err)
+	}
+	if exported != testProfile {
+		t.Fatalf("Profile did not round trip.\nExpected:\n%s\nActual:\n%s", testProfile, exported)
+	}
+}
+
+func TestImportUnknownIDs(t *testing.T) {
+	profile := `race: Orc
+class: Shaman
+spec: ElementalShaman
+head: 1 enchant=1000 gems=11,10
+neck: 3
+`
+	settings, warnings, err := Import(profile, testDatabase(t))
+	if err != nil {
+		t.Fatalf("Import failed: %s", err)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("Expected 2 warnings but got: %v", warnings)
+	}
+
+	head := settings.Player.Equipment.Items[proto.ItemSlot_ItemSlotHead]
+	if head.Enchant != 100 {
+		t.Fatalf("Expected enchant effect ID to be mapped to enchant 100, got %d", head.Enchant)
+	}
+	if head.Gems[0] != 0 || head.Gems[1] != 10 {
+		t.Fatalf("Expected unknown gem to be dropped, got %v", head.Gems)
+	}
+	if settings.Player.Equipment.Items[proto.ItemSlot_ItemSlotNeck].Id != 0 {
+		t.Fatalf("Expected unknown item to be dropped")
+	}
+}
+
+func TestImportMissingSpec(t *testing.T) {
+	if _, _, err := Import("class: Shaman\n", testDatabase(t)); err == nil {
+		t.Fatalf("Expected error for shaman profile with no spec")
+	}
+
+	settings, _, err := Import("class: Mage\n", testDatabase(t))
+	if err != nil {
+		t.Fatalf("Import failed: %s", err)
+	}
+	if settings.Player.GetMage() == nil {
+		t.Fatalf("Expected mage spec to be inferred from class")
+	}
+}
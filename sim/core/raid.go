@@ -3,6 +3,7 @@ package core
 import (
 	"time"
 
+	"github.com/wowsims/tbc/sim/core/items"
 	"github.com/wowsims/tbc/sim/core/proto"
 	"github.com/wowsims/tbc/sim/core/stats"
 )
@@ -18,7 +19,27 @@ type Party struct {
 	dpsMetrics DistributionMetrics
 }
 
-func NewParty(index int, partyConfig proto.Party) *Party {
+// Whether a player in this party returns mana with Vampiric Touch.
+func (party *Party) hasVampiricTouchMana() bool {
+	for _, player := range party.Players {
+		if player.GetCharacter().GivesVampiricTouchMana {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether a player in this party casts Mana Tide Totem.
+func (party *Party) hasManaTideTotem() bool {
+	for _, player := range party.Players {
+		if player.GetCharacter().GivesManaTideTotem {
+			return true
+		}
+	}
+	return false
+}
+
+func NewParty(index int, partyConfig proto.Party, itemDB *items.Database) *Party {
 	party := &Party{
 		Index:      index,
 		dpsMetrics: NewDistributionMetrics(),
@@ -26,7 +47,7 @@ func NewParty(index int, partyConfig proto.Party) *Party {
 
 	for playerIndex, playerConfig := range partyConfig.Players {
 		if playerConfig != nil && playerConfig.Class != proto.Class_ClassUnknown {
-			party.Players = append(party.Players, NewAgent(party, playerIndex, *playerConfig))
+			party.Players = append(party.Players, NewAgent(party, playerIndex, *playerConfig, itemDB))
 		}
 	}
 
@@ -131,13 +152,19 @@ type Raid struct {
 
 // Makes a new raid.
 func NewRaid(raidConfig proto.Raid) *Raid {
+	return NewRaidWithItems(raidConfig, items.DefaultDatabase)
+}
+
+// Makes a new raid, looking up equipment in the given item database instead of
+// the default one.
+func NewRaidWithItems(raidConfig proto.Raid, itemDB *items.Database) *Raid {
 	raid := &Raid{
 		dpsMetrics: NewDistributionMetrics(),
 	}
 
 	for partyIndex, partyConfig := range raidConfig.Parties {
 		if partyConfig != nil {
-			raid.Parties = append(raid.Parties, NewParty(partyIndex, *partyConfig))
+			raid.Parties = append(raid.Parties, NewParty(partyIndex, *partyConfig, itemDB))
 		}
 	}
 
@@ -200,6 +227,10 @@ func (raid *Raid) finalize(raidConfig proto.Raid) {
 			player.AddPartyBuffs(&partyBuffs)
 			player.GetCharacter().AddPartyBuffs(&partyBuffs)
 		}
+		if party.hasManaTideTotem() {
+			// The real shaman's Mana Tide replaces the estimate.
+			partyBuffs.ManaTideTotems = 0
+		}
 
 		// Apply all buffs to the players in this party.
 		for playerIdx, player := range party.Players {
@@ -208,6 +239,10 @@ func (raid *Raid) finalize(raidConfig proto.Raid) {
 			if playerConfig.Buffs != nil {
 				individualBuffs = *playerConfig.Buffs
 			}
+			if party.hasVampiricTouchMana() {
+				// The real shadow priest's mana returns replace the estimate.
+				individualBuffs.ShadowPriestDps = 0
+			}
 
 			player.GetCharacter().applyAllEffects(player)
 			applyBuffEffects(player, raidBuffs, partyBuffs, individualBuffs)
@@ -251,6 +286,17 @@ func (raid Raid) GetPlayerFromRaidTarget(raidTarget proto.RaidTarget) Agent {
 	return party.Players[playerIndex]
 }
 
+// Returns all players in the raid, not including pets.
+func (raid *Raid) AllCharacters() []*Character {
+	characters := []*Character{}
+	for _, party := range raid.Parties {
+		for _, player := range party.Players {
+			characters = append(characters, player.GetCharacter())
+		}
+	}
+	return characters
+}
+
 func (raid *Raid) reset(sim *Simulation) {
 	for _, party := range raid.Parties {
 		party.reset(sim)
@@ -0,0 +1,386 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Max number of configurations for which SearchMethodAuto uses a grid search.
+const maxGridConfigurations = 256
+
+const defaultMaxRotationPasses = 3
+
+type rotationParameter struct {
+	config *proto.RotationParameter
+
+	// Values to try, in increasing order.
+	values []float64
+}
+
+// Searches for the values of numeric player options, e.g. rotation
+// thresholds, which give the highest raid DPS. Every configuration is simmed
+// with the same random seed, so differences in DPS come from the options
+// rather than from noise.
+type rotationOptimizer struct {
+	request    *proto.RotationOptimizerRequest
+	simOptions *proto.SimOptions
+
+	// Copy of the input raid. Configurations are applied to copies of this.
+	raid *proto.Raid
+
+	parameters []rotationParameter
+
+	// Configurations which have already been simmed, in the order they were tried.
+	samples      []*proto.RotationSample
+	samplesByKey map[string]*proto.RotationSample
+	numSims      int32
+}
+
+func optimizeRotation(request *proto.RotationOptimizerRequest) *proto.RotationOptimizerResult {
+	result, err := runRotationOptimizer(request)
+	if err != nil {
+		return &proto.RotationOptimizerResult{
+			ErrorResult: err.Error(),
+		}
+	}
+	return result
+}
+
+func runRotationOptimizer(request *proto.RotationOptimizerRequest) (*proto.RotationOptimizerResult, error) {
+	optimizer, err := newRotationOptimizer(request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every sim shares the encounter and items, so a bad request fails here.
+	inputSample, err := optimizer.evaluate(optimizer.inputValues())
+	if err != nil {
+		return nil, err
+	}
+
+	method := request.SearchMethod
+	if method == proto.RotationOptimizerRequest_SearchMethodAuto {
+		if optimizer.numConfigurations() <= maxGridConfigurations {
+			method = proto.RotationOptimizerRequest_SearchMethodGrid
+		} else {
+			method = proto.RotationOptimizerRequest_SearchMethodCoordinateDescent
+		}
+	}
+
+	var bestSample *proto.RotationSample
+	if method == proto.RotationOptimizerRequest_SearchMethodGrid {
+		bestSample, err = optimizer.gridSearch(inputSample)
+	} else {
+		bestSample, err = optimizer.coordinateDescent(inputSample)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RotationOptimizerResult{
+		BestValues: bestSample.Values,
+		Raid:       optimizer.buildRaid(bestSample.Values),
+		Dps:        bestSample.Dps,
+		InputDps:   inputSample.Dps,
+		DpsGain:    bestSample.Dps - inputSample.Dps,
+		Samples:    optimizer.samples,
+		NumSims:    optimizer.numSims,
+	}, nil
+}
+
+func newRotationOptimizer(request *proto.RotationOptimizerRequest) (*rotationOptimizer, error) {
+	if request.RaidSimRequest == nil || request.RaidSimRequest.Raid == nil {
+		return nil, fmt.Errorf("rotation optimizer request must have a raid")
+	}
+
+	optimizer := &rotationOptimizer{
+		request:      request,
+		simOptions:   optimizerSimOptions(request.RaidSimRequest.SimOptions),
+		raid:         googleProto.Clone(request.RaidSimRequest.Raid).(*proto.Raid),
+		samplesByKey: make(map[string]*proto.RotationSample),
+	}
+
+	for _, config := range request.Parameters {
+		// Check the path up front, so bad requests fail before any sims are run.
+		_, field, err := getRotationField(optimizer.cloneRaid(), config)
+		if err != nil {
+			return nil, err
+		}
+
+		if config.Step <= 0 {
+			return nil, fmt.Errorf("step for %s must be positive, got %f", config.Path, config.Step)
+		}
+		if config.MaxValue < config.MinValue {
+			return nil, fmt.Errorf("invalid range for %s: %f to %f", config.Path, config.MinValue, config.MaxValue)
+		}
+
+		parameter := rotationParameter{config: config}
+		numSteps := int(math.Floor((config.MaxValue-config.MinValue)/config.Step + 1e-9))
+		for i := 0; i <= numSteps; i++ {
+			value := config.MinValue + float64(i)*config.Step
+			if isIntegerField(field) {
+				value = math.Round(value)
+				if len(parameter.values) > 0 && parameter.values[len(parameter.values)-1] == value {
+					continue
+				}
+			}
+			parameter.values = append(parameter.values, value)
+		}
+		optimizer.parameters = append(optimizer.parameters, parameter)
+	}
+
+	return optimizer, nil
+}
+
+func (optimizer *rotationOptimizer) cloneRaid() *proto.Raid {
+	return googleProto.Clone(optimizer.raid).(*proto.Raid)
+}
+
+// Returns the message in raid holding the parameter's field, and the field
+// itself. Unset messages along the path are created, so raid should be a copy.
+func getRotationField(raid *proto.Raid, config *proto.RotationParameter) (protoreflect.Message, protoreflect.FieldDescriptor, error) {
+	partyIdx := int(config.RaidIndex) / maxPartySize
+	playerIdx := int(config.RaidIndex) % maxPartySize
+	if config.RaidIndex < 0 || partyIdx >= len(raid.Parties) || playerIdx >= len(raid.Parties[partyIdx].GetPlayers()) {
+		return nil, nil, fmt.Errorf("no player at raid index %d", config.RaidIndex)
+	}
+	player := raid.Parties[partyIdx].Players[playerIdx]
+	if player == nil {
+		return nil, nil, fmt.Errorf("no player at raid index %d", config.RaidIndex)
+	}
+
+	message := player.ProtoReflect()
+	names := strings.Split(config.Path, ".")
+	for i, name := range names {
+		field := message.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, nil, fmt.Errorf("no field %s in %s", name, message.Descriptor().FullName())
+		}
+		if field.IsList() || field.IsMap() {
+			return nil, nil, fmt.Errorf("field %s in %s is repeated", name, config.Path)
+		}
+
+		if i == len(names)-1 {
+			if !isNumericField(field) {
+				return nil, nil, fmt.Errorf("field %s is not numeric", config.Path)
+			}
+			return message, field, nil
+		}
+
+		if field.Kind() != protoreflect.MessageKind {
+			return nil, nil, fmt.Errorf("field %s in %s is not a message", name, config.Path)
+		}
+		// Mutable() would switch oneofs, e.g. the player's spec, to this field.
+		if field.ContainingOneof() != nil && !message.Has(field) {
+			return nil, nil, fmt.Errorf("field %s in %s is not set", name, config.Path)
+		}
+		message = message.Mutable(field).Message()
+	}
+
+	return nil, nil, fmt.Errorf("invalid path: %s", config.Path)
+}
+
+// Like getRotationField, for parameters which were already checked by
+// newRotationOptimizer.
+func mustGetRotationField(raid *proto.Raid, config *proto.RotationParameter) (protoreflect.Message, protoreflect.FieldDescriptor) {
+	message, field, err := getRotationField(raid, config)
+	if err != nil {
+		panic(err)
+	}
+	return message, field
+}
+
+func isIntegerField(field protoreflect.FieldDescriptor) bool {
+	switch field.Kind() {
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return false
+	}
+	return true
+}
+
+func isNumericField(field protoreflect.FieldDescriptor) bool {
+	switch field.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind,
+		protoreflect.FloatKind, protoreflect.DoubleKind,
+		protoreflect.EnumKind:
+		return true
+	}
+	return false
+}
+
+func getNumericValue(message protoreflect.Message, field protoreflect.FieldDescriptor) float64 {
+	value := message.Get(field)
+	switch field.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return float64(value.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return float64(value.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return value.Float()
+	case protoreflect.EnumKind:
+		return float64(value.Enum())
+	}
+	panic(fmt.Sprintf("Field %s is not numeric", field.FullName()))
+}
+
+func setNumericValue(message protoreflect.Message, field protoreflect.FieldDescriptor, value float64) {
+	switch field.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		message.Set(field, protoreflect.ValueOfInt32(int32(math.Round(value))))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		message.Set(field, protoreflect.ValueOfInt64(int64(math.Round(value))))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		message.Set(field, protoreflect.ValueOfUint32(uint32(math.Round(math.Max(0, value)))))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		message.Set(field, protoreflect.ValueOfUint64(uint64(math.Round(math.Max(0, value)))))
+	case protoreflect.FloatKind:
+		message.Set(field, protoreflect.ValueOfFloat32(float32(value)))
+	case protoreflect.DoubleKind:
+		message.Set(field, protoreflect.ValueOfFloat64(value))
+	case protoreflect.EnumKind:
+		message.Set(field, protoreflect.ValueOfEnum(protoreflect.EnumNumber(math.Round(value))))
+	default:
+		panic(fmt.Sprintf("Field %s is not numeric", field.FullName()))
+	}
+}
+
+func (optimizer *rotationOptimizer) inputValues() []float64 {
+	raid := optimizer.cloneRaid()
+	values := make([]float64, len(optimizer.parameters))
+	for i, parameter := range optimizer.parameters {
+		message, field := mustGetRotationField(raid, parameter.config)
+		values[i] = getNumericValue(message, field)
+	}
+	return values
+}
+
+// Returns the number of configurations in a grid search, capped just above
+// maxGridConfigurations.
+func (optimizer *rotationOptimizer) numConfigurations() int {
+	numConfigurations := 1
+	for _, parameter := range optimizer.parameters {
+		numConfigurations *= len(parameter.values)
+		if numConfigurations > maxGridConfigurations {
+			return maxGridConfigurations + 1
+		}
+	}
+	return numConfigurations
+}
+
+// Sims every combination of parameter values.
+func (optimizer *rotationOptimizer) gridSearch(inputSample *proto.RotationSample) (*proto.RotationSample, error) {
+	bestSample := inputSample
+	values := make([]float64, len(optimizer.parameters))
+
+	var search func(parameterIdx int) error
+	search = func(parameterIdx int) error {
+		if parameterIdx == len(optimizer.parameters) {
+			sample, err := optimizer.evaluate(values)
+			if err != nil {
+				return err
+			}
+			if sample.Dps > bestSample.Dps {
+				bestSample = sample
+			}
+			return nil
+		}
+
+		for _, value := range optimizer.parameters[parameterIdx].values {
+			values[parameterIdx] = value
+			if err := search(parameterIdx + 1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := search(0); err != nil {
+		return nil, err
+	}
+
+	return bestSample, nil
+}
+
+// Starting from the input values, tries every value of one parameter at a
+// time while keeping the others fixed, until a pass makes no improvement.
+func (optimizer *rotationOptimizer) coordinateDescent(inputSample *proto.RotationSample) (*proto.RotationSample, error) {
+	maxPasses := int(optimizer.request.MaxPasses)
+	if maxPasses <= 0 {
+		maxPasses = defaultMaxRotationPasses
+	}
+
+	bestSample := inputSample
+	for pass := 0; pass < maxPasses; pass++ {
+		improved := false
+		for parameterIdx, parameter := range optimizer.parameters {
+			values := make([]float64, len(bestSample.Values))
+			copy(values, bestSample.Values)
+
+			for _, value := range parameter.values {
+				values[parameterIdx] = value
+				sample, err := optimizer.evaluate(values)
+				if err != nil {
+					return nil, err
+				}
+				if sample.Dps > bestSample.Dps {
+					bestSample = sample
+					improved = true
+				}
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+
+	return bestSample, nil
+}
+
+// Runs a raid sim with the given parameter values. Configurations which were
+// already simmed are not simmed again.
+func (optimizer *rotationOptimizer) evaluate(values []float64) (*proto.RotationSample, error) {
+	key := fmt.Sprint(values)
+	if sample, ok := optimizer.samplesByKey[key]; ok {
+		return sample, nil
+	}
+
+	optimizer.numSims++
+	result := RunRaidSim(&proto.RaidSimRequest{
+		Raid:            optimizer.buildRaid(values),
+		Encounter:       optimizer.request.RaidSimRequest.Encounter,
+		SimOptions:      optimizer.simOptions,
+		CustomItems:     optimizer.request.RaidSimRequest.CustomItems,
+		PresetEncounter: optimizer.request.RaidSimRequest.PresetEncounter,
+	})
+	if result.ErrorResult != "" {
+		return nil, errors.New(result.ErrorResult)
+	}
+
+	sample := &proto.RotationSample{
+		Values:   append([]float64{}, values...),
+		Dps:      result.RaidMetrics.Dps.Avg,
+		DpsStdev: result.RaidMetrics.Dps.Stdev,
+	}
+	optimizer.samples = append(optimizer.samples, sample)
+	optimizer.samplesByKey[key] = sample
+	return sample, nil
+}
+
+// Returns a copy of the input raid with the given parameter values applied.
+func (optimizer *rotationOptimizer) buildRaid(values []float64) *proto.Raid {
+	raid := optimizer.cloneRaid()
+	for i, parameter := range optimizer.parameters {
+		message, field := mustGetRotationField(raid, parameter.config)
+		setNumericValue(message, field, values[i])
+	}
+	return raid
+}
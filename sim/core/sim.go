@@ -7,6 +7,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/wowsims/tbc/sim/core/encounters"
+	"github.com/wowsims/tbc/sim/core/items"
 	"github.com/wowsims/tbc/sim/core/proto"
 )
 
@@ -40,7 +42,18 @@ type Simulation struct {
 }
 
 func RunSim(rsr proto.RaidSimRequest, progress chan *proto.ProgressMetrics) *proto.RaidSimResult {
-	sim := newSim(rsr)
+	sim, err := newSim(rsr)
+	if err != nil {
+		result := &proto.RaidSimResult{
+			ErrorResult: err.Error(),
+		}
+		if progress != nil {
+			progress <- &proto.ProgressMetrics{
+				FinalRaidResult: result,
+			}
+		}
+		return result
+	}
 	sim.runPresims(rsr)
 	if progress != nil {
 		sim.ProgressReport = func(progMetric *proto.ProgressMetrics) {
@@ -50,15 +63,43 @@ func RunSim(rsr proto.RaidSimRequest, progress chan *proto.ProgressMetrics) *pro
 	return sim.run()
 }
 
-func newSim(rsr proto.RaidSimRequest) *Simulation {
-	raid := NewRaid(*rsr.Raid)
-	encounter := NewEncounter(*rsr.Encounter)
+func newSim(rsr proto.RaidSimRequest) (*Simulation, error) {
+	itemDB, err := items.DefaultDatabase.WithCustomItems(rsr.CustomItems)
+	if err != nil {
+		return nil, fmt.Errorf("invalid custom items: %s", err)
+	}
+
+	if err := validateTalentsStrings(rsr.Raid); err != nil {
+		return nil, err
+	}
+	raid := NewRaidWithItems(*rsr.Raid, itemDB)
+
+	encounterProto := rsr.Encounter
+	if rsr.PresetEncounter != "" {
+		encounterProto, err = encounters.Expand(rsr.PresetEncounter, rsr.Encounter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	encounter := NewEncounter(*encounterProto)
 	simOptions := *rsr.SimOptions
 
 	if len(encounter.Targets) == 0 {
 		panic("Must have at least 1 target!")
 	}
 
+	defaultLatency := NewLatency(simOptions.Latency)
+	for _, party := range raid.Parties {
+		for _, player := range party.Players {
+			character := player.GetCharacter()
+			character.validateTargetAssignment(len(encounter.Targets))
+			character.Latency.applyDefaults(defaultLatency)
+			if err := character.validateAPLRotation(); err != nil {
+				return nil, fmt.Errorf("invalid APL rotation for %s: %s", character.Name, err)
+			}
+		}
+	}
+
 	rseed := simOptions.RandomSeed
 	if rseed == 0 {
 		rseed = time.Now().Unix()
@@ -80,7 +121,7 @@ func newSim(rsr proto.RaidSimRequest) *Simulation {
 		emptyAuras: make([]Aura, numAuraIDs),
 
 		pendingActionPool: newPAPool(),
-	}
+	}, nil
 }
 
 // Returns a random float.
@@ -126,8 +167,11 @@ func (sim *Simulation) reset() {
 	for _, target := range sim.encounter.Targets {
 		target.Reset(sim)
 	}
+	sim.encounter.resetPhases(sim)
 
 	sim.Raid.reset(sim)
+	sim.encounter.resetMovements(sim)
+	sim.encounter.resetDamageIntake(sim)
 
 	sim.initManaTickAction()
 }
@@ -285,12 +329,26 @@ func (sim *Simulation) advance(elapsedTime time.Duration) {
 	}
 }
 
+// Whether the primary target is in execute range. For targets with health
+// this comes from their current health, otherwise from the encounter's
+// execute proportion.
 func (sim *Simulation) IsExecutePhase() bool {
+	if target := sim.GetPrimaryTarget(); target.HasHealth() {
+		return target.CurrentHealthPercent() < ExecutePhaseHealthFraction
+	}
 	return sim.CurrentTime > sim.encounter.executePhaseBegins
 }
 
+// Returns the time remaining in the current iteration. When the iteration
+// ends on target death, this is the estimated time to kill if that's sooner.
 func (sim *Simulation) GetRemainingDuration() time.Duration {
-	return sim.Duration - sim.CurrentTime
+	remaining := sim.Duration - sim.CurrentTime
+	if sim.encounter.EndWhenTargetsDie {
+		if estimate, ok := sim.encounter.estimatedTimeToKill(sim); ok {
+			remaining = MinDuration(remaining, estimate)
+		}
+	}
+	return remaining
 }
 
 // Returns the percentage of time remaining in the current iteration, as a value from 0-1.
@@ -305,7 +363,3 @@ func (sim *Simulation) GetNumTargets() int32 {
 func (sim *Simulation) GetTarget(index int32) *Target {
 	return sim.encounter.Targets[index]
 }
-
-func (sim *Simulation) GetPrimaryTarget() *Target {
-	return sim.GetTarget(0)
-}
@@ -42,6 +42,8 @@ type SpellCast struct {
 	PartialResists_3_4 int32   // 3/4 of the spell was resisted
 	TotalDamage        float64 // Damage done by this cast.
 	TotalThreat        float64 // Threat generated by this cast.
+	TotalHealing       float64 // Healing done by this cast, not including overhealing.
+	TotalOverhealing   float64 // Healing done by this cast beyond the targets' max health.
 }
 
 type SpellEffect struct {
@@ -136,7 +138,7 @@ func (spellEffect *SpellEffect) afterCalculations(sim *Simulation, spellCast *Sp
 
 // Calculates a hit check using the stats from this spell.
 func (spellEffect *SpellEffect) hitCheck(sim *Simulation, spellCast *SpellCast) bool {
-	hit := 0.83 + (spellCast.Character.GetStat(stats.SpellHit)+spellEffect.BonusSpellHitRating)/(SpellHitRatingPerHitChance*100)
+	hit := spellEffect.Target.SpellHitChance() + (spellCast.Character.GetStat(stats.SpellHit)+spellEffect.BonusSpellHitRating)/(SpellHitRatingPerHitChance*100)
 	hit = MinFloat(hit, 0.99) // can't get away from the 1% miss
 
 	return sim.RandomFloat("SpellCast Hit") < hit
@@ -148,7 +150,7 @@ func (spellEffect *SpellEffect) critCheck(sim *Simulation, spellCast *SpellCast)
 	return sim.RandomFloat("DirectSpell Crit") < critChance
 }
 
-func (spellEffect *SpellEffect) applyResultsToCast(spellCast *SpellCast) {
+func (spellEffect *SpellEffect) applyResultsToCast(sim *Simulation, spellCast *SpellCast) {
 	if spellEffect.Hit {
 		spellCast.Hits++
 		if spellEffect.Crit {
@@ -167,11 +169,12 @@ func (spellEffect *SpellEffect) applyResultsToCast(spellCast *SpellCast) {
 	}
 
 	spellCast.TotalDamage += spellEffect.Damage
+	spellEffect.Target.takeDamage(sim, spellCast.Character, spellCast.ActionID, spellCast.SpellSchool, spellEffect.Damage)
 	spellCast.TotalThreat += spellEffect.Damage * spellEffect.TotalThreatMultiplier(spellCast)
 }
 
 // Only applies the results from the ticks, not the initial dot application.
-func (hitEffect *SpellHitEffect) applyDotTickResultsToCast(spellCast *SpellCast) {
+func (hitEffect *SpellHitEffect) applyDotTickResultsToCast(sim *Simulation, spellCast *SpellCast) {
 	if hitEffect.DotInput.TicksCanMissAndCrit {
 		if hitEffect.Hit {
 			spellCast.Hits++
@@ -192,6 +195,7 @@ func (hitEffect *SpellHitEffect) applyDotTickResultsToCast(spellCast *SpellCast)
 	}
 
 	spellCast.TotalDamage += hitEffect.Damage
+	hitEffect.Target.takeDamage(sim, spellCast.Character, spellCast.ActionID, spellCast.SpellSchool, hitEffect.Damage)
 	spellCast.TotalThreat += hitEffect.Damage * hitEffect.TotalThreatMultiplier(spellCast)
 }
 
@@ -203,10 +207,10 @@ func (hitEffect *SpellHitEffect) calculateDirectDamage(sim *Simulation, spellCas
 
 	damage := baseDamage + damageFromSpellPower + hitEffect.DirectInput.FlatDamageBonus
 
-	damage *= hitEffect.SpellEffect.DamageMultiplier * hitEffect.SpellEffect.StaticDamageMultiplier
+	damage *= hitEffect.SpellEffect.DamageMultiplier * hitEffect.SpellEffect.StaticDamageMultiplier * hitEffect.Target.DamageTakenMultiplier
 
 	if !spellCast.Binary {
-		damage = calculateResists(sim, damage, &hitEffect.SpellEffect)
+		damage = calculateResists(sim, damage, &hitEffect.SpellEffect, spellCast)
 	}
 
 	if hitEffect.SpellEffect.critCheck(sim, spellCast) {
@@ -245,6 +249,7 @@ func (hitEffect *SpellHitEffect) calculateDotDamage(sim *Simulation, spellCast *
 	if hitEffect.DotInput.IgnoreDamageModifiers {
 		damage = hitEffect.DotInput.damagePerTick
 	}
+	damage *= hitEffect.Target.DamageTakenMultiplier
 
 	hitEffect.Hit = !hitEffect.DotInput.TicksCanMissAndCrit || hitEffect.hitCheck(sim, spellCast)
 	hitEffect.Crit = false
@@ -254,7 +259,7 @@ func (hitEffect *SpellHitEffect) calculateDotDamage(sim *Simulation, spellCast *
 			hitEffect.PartialResist_1_4 = false
 			hitEffect.PartialResist_2_4 = false
 			hitEffect.PartialResist_3_4 = false
-			damage = calculateResists(sim, damage, &hitEffect.SpellEffect)
+			damage = calculateResists(sim, damage, &hitEffect.SpellEffect, spellCast)
 		}
 
 		if hitEffect.DotInput.TicksCanMissAndCrit && hitEffect.critCheck(sim, spellCast) {
@@ -275,7 +280,7 @@ func (hitEffect *SpellHitEffect) afterDotTick(sim *Simulation, spellCast *SpellC
 		spellCast.Character.Log(sim, "%s %s.", spellCast.ActionID, hitEffect.SpellEffect.DotResultString())
 	}
 
-	hitEffect.applyDotTickResultsToCast(spellCast)
+	hitEffect.applyDotTickResultsToCast(sim, spellCast)
 
 	if hitEffect.DotInput.TicksProcSpellHitEffects {
 		hitEffect.SpellEffect.triggerSpellProcs(sim, spellCast)
@@ -350,30 +355,68 @@ func (spellEffect *SpellEffect) DotResultString() string {
 	return sb.String()
 }
 
-// Return value is (newDamage, resistMultiplier)
-func calculateResists(sim *Simulation, damage float64, spellEffect *SpellEffect) float64 {
-	// Average Resistance (AR) = (Target's Resistance / (Caster's Level * 5)) * 0.75
-	// P(x) = 50% - 250%*|x - AR| <- where X is %resisted
-	// Using these stats:
-	//    13.6% chance of
-	//  FUTURE: handle boss resists for fights/classes that are actually impacted by that.
-	resVal := sim.RandomFloat("DirectSpell Resist")
-	if resVal > 0.18 { // 13% chance for 25% resist, 4% for 50%, 1% for 75%
-		// No partial resist.
+// Chances of a 0%, 25%, 50%, and 75% partial resist against a level 73 boss
+// with no resistances, which resists 6% of damage on average.
+var bossPartialResistChances = [4]float64{0.82, 0.13, 0.04, 0.01}
+
+const bossAverageResist = 0.06
+
+// Returns the chances of a 0%, 25%, 50%, and 75% partial resist for a target
+// which resists averageResist of all damage on average.
+//
+// The chances are interpolated between reference distributions: no resists
+// at 0, the observed boss distribution at 6%, and every hit resisted by
+// exactly 25%, 50%, or 75% at those values. This keeps the average resist
+// exactly equal to averageResist.
+func partialResistChances(averageResist float64) [4]float64 {
+	noResists := [4]float64{1, 0, 0, 0}
+	allResisted := func(bucket int) [4]float64 {
+		chances := [4]float64{}
+		chances[bucket] = 1
+		return chances
+	}
+	lerp := func(from [4]float64, to [4]float64, t float64) [4]float64 {
+		chances := [4]float64{}
+		for i := range chances {
+			chances[i] = from[i] + (to[i]-from[i])*t
+		}
+		return chances
+	}
+
+	if averageResist <= 0 {
+		return noResists
+	} else if averageResist <= bossAverageResist {
+		return lerp(noResists, bossPartialResistChances, averageResist/bossAverageResist)
+	} else if averageResist <= 0.25 {
+		return lerp(bossPartialResistChances, allResisted(1), (averageResist-bossAverageResist)/(0.25-bossAverageResist))
+	} else if averageResist <= 0.5 {
+		return lerp(allResisted(1), allResisted(2), (averageResist-0.25)/0.25)
+	} else {
+		return lerp(allResisted(2), allResisted(3), (MinFloat(averageResist, 0.75)-0.5)/0.25)
+	}
+}
+
+// Applies a partial resist roll to the damage, based on the target's level and
+// resistance to the spell's school minus the caster's spell penetration.
+func calculateResists(sim *Simulation, damage float64, spellEffect *SpellEffect, spellCast *SpellCast) float64 {
+	averageResist := spellEffect.Target.AverageResist(spellCast.SpellSchool, spellCast.Character.GetStat(stats.SpellPenetration))
+	if averageResist <= 0 {
 		return damage
 	}
+	chances := partialResistChances(averageResist)
 
-	var multiplier float64
-	if resVal < 0.01 {
+	resVal := sim.RandomFloat("DirectSpell Resist")
+	if resVal < chances[3] {
 		spellEffect.PartialResist_3_4 = true
-		multiplier = 0.25
-	} else if resVal < 0.05 {
+		return damage * 0.25
+	} else if resVal < chances[3]+chances[2] {
 		spellEffect.PartialResist_2_4 = true
-		multiplier = 0.5
-	} else {
+		return damage * 0.5
+	} else if resVal < chances[3]+chances[2]+chances[1] {
 		spellEffect.PartialResist_1_4 = true
-		multiplier = 0.75
+		return damage * 0.75
 	}
 
-	return damage * multiplier
+	// No partial resist.
+	return damage
 }
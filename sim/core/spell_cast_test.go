@@ -0,0 +1,67 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+func TestPartialResistChancesAverage(t *testing.T) {
+	for _, averageResist := range []float64{0, 0.03, 0.06, 0.1, 0.25, 0.4, 0.6, 0.75} {
+		chances := partialResistChances(averageResist)
+
+		total := 0.0
+		average := 0.0
+		for i, chance := range chances {
+			total += chance
+			average += chance * 0.25 * float64(i)
+		}
+		if math.Abs(total-1) > 0.0001 {
+			t.Fatalf("Chances for %0.2f average resist sum to %0.4f", averageResist, total)
+		}
+		if math.Abs(average-averageResist) > 0.0001 {
+			t.Fatalf("Chances for %0.2f average resist have average %0.4f", averageResist, average)
+		}
+	}
+}
+
+func TestTargetAverageResist(t *testing.T) {
+	target := NewTarget(proto.Target{ShadowResistance: 100}, 0)
+
+	if ar := target.AverageResist(stats.FireSpellPower, 0); math.Abs(ar-bossAverageResist) > 0.0001 {
+		t.Fatalf("Expected level-based resist of %0.3f, got %0.3f", bossAverageResist, ar)
+	}
+
+	expected := bossAverageResist + 0.75*100/350
+	if ar := target.AverageResist(stats.ShadowSpellPower, 0); math.Abs(ar-expected) > 0.0001 {
+		t.Fatalf("Expected %0.3f, got %0.3f", expected, ar)
+	}
+
+	// Spell penetration can't reduce below the level-based resist.
+	if ar := target.AverageResist(stats.ShadowSpellPower, 150); math.Abs(ar-bossAverageResist) > 0.0001 {
+		t.Fatalf("Expected %0.3f, got %0.3f", bossAverageResist, ar)
+	}
+}
+
+func TestTargetCurseResistanceReduction(t *testing.T) {
+	target := NewTarget(proto.Target{ShadowResistance: 100}, 0)
+	sim := &Simulation{}
+	target.auraTracker.reset(sim)
+
+	target.AddAura(sim, CurseOfElementsAura(proto.TristateEffect_TristateEffectRegular))
+	target.AddAura(sim, CurseOfShadowAura(proto.TristateEffect_TristateEffectRegular))
+	if resistance := target.Resistance(stats.ShadowSpellPower); resistance != 100-2*curseResistanceReduction {
+		t.Fatalf("Expected both curses to reduce shadow resistance, got %0.0f", resistance)
+	}
+	if resistance := target.Resistance(stats.FireSpellPower); resistance != -curseResistanceReduction {
+		t.Fatalf("Expected only Curse of the Elements to reduce fire resistance, got %0.0f", resistance)
+	}
+
+	target.RemoveAura(sim, CurseOfElementsDebuffID)
+	target.RemoveAura(sim, CurseOfShadowDebuffID)
+	if resistance := target.Resistance(stats.ShadowSpellPower); resistance != 100 {
+		t.Fatalf("Expected the reduction to end with the curses, got %0.0f", resistance)
+	}
+}
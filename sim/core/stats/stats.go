@@ -345,6 +345,11 @@ type PseudoStats struct {
 	BonusMeleeDamage  float64 // Comes from '+X Weapon Damage' effects, affects melee hits only.
 	BonusRangedDamage float64 // Comes from '+X Weapon Damage' effects, affects ranged hits only.
 
+	// Bonus weapon skill for each weapon, e.g. from racials.
+	BonusMHWeaponSkill     float64
+	BonusOHWeaponSkill     float64
+	BonusRangedWeaponSkill float64
+
 	ThreatMultiplier float64 // Modulates the threat generated. Affected by things like salv.
 }
 
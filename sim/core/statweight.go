@@ -25,14 +25,19 @@ func CalcStatWeight(swr proto.StatWeightsRequest, statsToWeigh []stats.Stat, ref
 
 	raidProto := SinglePlayerRaidProto(swr.Player, swr.PartyBuffs, swr.RaidBuffs)
 	baseStatsResult := ComputeStats(&proto.ComputeStatsRequest{
-		Raid: raidProto,
+		Raid:        raidProto,
+		CustomItems: swr.CustomItems,
 	})
+	if baseStatsResult.ErrorResult != "" {
+		panic(baseStatsResult.ErrorResult)
+	}
 	baseStats := baseStatsResult.RaidStats.Parties[0].Players[0].FinalStats
 
 	baseSimRequest := &proto.RaidSimRequest{
-		Raid:       raidProto,
-		Encounter:  swr.Encounter,
-		SimOptions: swr.SimOptions,
+		Raid:        raidProto,
+		Encounter:   swr.Encounter,
+		SimOptions:  swr.SimOptions,
+		CustomItems: swr.CustomItems,
 	}
 	baselineResult := RunRaidSim(baseSimRequest)
 	baselineDpsMetrics := baselineResult.RaidMetrics.Parties[0].Players[0].Dps
@@ -0,0 +1,90 @@
+package talents
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func init() {
+	registerClass(proto.Class_ClassDruid, func() googleProto.Message { return &proto.DruidTalents{} }, druidTalentTrees)
+}
+
+var druidTalentTrees = []TalentTree{
+	{
+		Name: "Balance",
+		Talents: []Talent{
+			{FieldName: "starlight_wrath", Location: TalentLocation{Row: 0, Col: 0}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 0, Col: 2}, PrereqLocation: &TalentLocation{Row: 0, Col: 1}, MaxPoints: 4},
+			{Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 3},
+			{FieldName: "focused_starlight", Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 2},
+			{FieldName: "improved_moonfire", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 2},
+			{FieldName: "brambles", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 3},
+			{FieldName: "insect_swarm", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 2},
+			{FieldName: "vengeance", Location: TalentLocation{Row: 3, Col: 1}, PrereqLocation: &TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 3},
+			{FieldName: "lunar_guidance", Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 3},
+			{FieldName: "natures_grace", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "moonglow", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 3},
+			{FieldName: "moonfury", Location: TalentLocation{Row: 5, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 5},
+			{FieldName: "balance_of_power", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 2},
+			{FieldName: "dreamstate", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "moonkin_form", Location: TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+			{FieldName: "improved_faerie_fire", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "wrath_of_cenarius", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "force_of_nature", Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Feral Combat",
+		Talents: []Talent{
+			{FieldName: "ferocity", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "feral_aggresion", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 1},
+			{FieldName: "sharpened_claws", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 3},
+			{FieldName: "shredding_attacks", Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{FieldName: "predatory_strikes", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 3},
+			{FieldName: "primal_fury", Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 2},
+			{FieldName: "savage_fury", Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{FieldName: "faerie_fire", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 2},
+			{FieldName: "heart_of_the_wild", Location: TalentLocation{Row: 5, Col: 1}, PrereqLocation: &TalentLocation{Row: 3, Col: 1}, MaxPoints: 5},
+			{FieldName: "survival_of_the_fittest", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "leader_of_the_pack", Location: TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+			{FieldName: "improved_leader_of_the_pack", Location: TalentLocation{Row: 6, Col: 2}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 2},
+			{FieldName: "predatory_instincts", Location: TalentLocation{Row: 7, Col: 2}, MaxPoints: 5},
+			{FieldName: "mangle", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Restoration",
+		Talents: []Talent{
+			{FieldName: "improved_mark_of_the_wild", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "furor", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "naturalist", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{FieldName: "natural_shapeshifter", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 3},
+			{FieldName: "intensity", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 3},
+			{FieldName: "subtlety", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 5},
+			{FieldName: "omen_of_clarity", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 5},
+			{FieldName: "improved_rejuvenation", Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 3},
+			{FieldName: "natures_swiftness", Location: TalentLocation{Row: 4, Col: 0}, PrereqLocation: &TalentLocation{Row: 2, Col: 0}, MaxPoints: 1},
+			{FieldName: "gift_of_nature", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "improved_regrowth", Location: TalentLocation{Row: 5, Col: 2}, PrereqLocation: &TalentLocation{Row: 3, Col: 2}, MaxPoints: 5},
+			{FieldName: "living_spirit", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "natural_perfection", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "empowered_rejuvenation", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "tree_of_life", Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+}
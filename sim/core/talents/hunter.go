@@ -0,0 +1,92 @@
+package talents
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func init() {
+	registerClass(proto.Class_ClassHunter, func() googleProto.Message { return &proto.HunterTalents{} }, hunterTalentTrees)
+}
+
+var hunterTalentTrees = []TalentTree{
+	{
+		Name: "Beast Mastery",
+		Talents: []Talent{
+			{FieldName: "improved_aspect_of_the_hawk", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "endurance_training", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "focused_fire", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 1, Col: 3}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 1},
+			{FieldName: "unleashed_fury", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 2},
+			{FieldName: "ferocity", Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "bestial_discipline", Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 2},
+			{FieldName: "animal_handler", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "frenzy", Location: TalentLocation{Row: 5, Col: 2}, PrereqLocation: &TalentLocation{Row: 3, Col: 2}, MaxPoints: 5},
+			{FieldName: "ferocious_inspiration", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "bestial_wrath", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "serpents_swiftness", Location: TalentLocation{Row: 7, Col: 2}, MaxPoints: 5},
+			{FieldName: "the_beast_within", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Marksmanship",
+		Talents: []Talent{
+			{Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "lethal_shots", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "improved_hunters_mark", Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{FieldName: "efficiency", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{FieldName: "go_for_the_throat", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{FieldName: "improved_arcane_shot", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 5},
+			{FieldName: "aimed_shot", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{FieldName: "rapid_killing", Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 2},
+			{FieldName: "improved_stings", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 5},
+			{FieldName: "mortal_shots", Location: TalentLocation{Row: 3, Col: 2}, PrereqLocation: &TalentLocation{Row: 2, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 3},
+			{FieldName: "scatter_shot", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "barrage", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 3},
+			{FieldName: "combat_experience", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "ranged_weapon_specialization", Location: TalentLocation{Row: 5, Col: 3}, MaxPoints: 5},
+			{FieldName: "careful_aim", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "trueshot_aura", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "improved_barrage", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "master_marksman", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "silencing_shot", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 7, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Survival",
+		Talents: []Talent{
+			{FieldName: "monster_slaying", Location: TalentLocation{Row: 0, Col: 0}, MaxPoints: 3},
+			{FieldName: "humanoid_slaying", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 3},
+			{FieldName: "savage_strikes", Location: TalentLocation{Row: 0, Col: 3}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 3},
+			{FieldName: "clever_traps", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{FieldName: "survivalist", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{FieldName: "trap_mastery", Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{FieldName: "surefooted", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 3}, MaxPoints: 2},
+			{FieldName: "survival_instincts", Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{FieldName: "killer_instinct", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 4, Col: 2}, PrereqLocation: &TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{FieldName: "resourcefulness", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 3},
+			{FieldName: "lightning_reflexes", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{FieldName: "thrill_of_the_hunt", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "expose_weakness", Location: TalentLocation{Row: 6, Col: 2}, PrereqLocation: &TalentLocation{Row: 5, Col: 2}, MaxPoints: 3},
+			{FieldName: "master_tactician", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "readiness", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 7, Col: 1}, MaxPoints: 1},
+		},
+	},
+}
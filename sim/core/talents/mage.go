@@ -0,0 +1,95 @@
+package talents
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func init() {
+	registerClass(proto.Class_ClassMage, func() googleProto.Message { return &proto.MageTalents{} }, mageTalentTrees)
+}
+
+var mageTalentTrees = []TalentTree{
+	{
+		Name: "Arcane",
+		Talents: []Talent{
+			{FieldName: "arcane_subtlety", Location: TalentLocation{Row: 0, Col: 0}, MaxPoints: 2},
+			{FieldName: "arcane_focus", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "wand_specialization", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{FieldName: "arcane_concentration", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{FieldName: "arcane_impact", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 2},
+			{FieldName: "arcane_meditation", Location: TalentLocation{Row: 3, Col: 3}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{FieldName: "presence_of_mind", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "arcane_mind", Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "arcane_instability", Location: TalentLocation{Row: 5, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 3},
+			{FieldName: "arcane_potency", Location: TalentLocation{Row: 5, Col: 2}, PrereqLocation: &TalentLocation{Row: 1, Col: 2}, MaxPoints: 3},
+			{FieldName: "empowered_arcane_missiles", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "arcane_power", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 5, Col: 1}, MaxPoints: 1},
+			{FieldName: "spell_power", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 2},
+			{FieldName: "mind_mastery", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Fire",
+		Talents: []Talent{
+			{FieldName: "improved_fireball", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "ignite", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 2},
+			{FieldName: "improved_fire_blast", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 3},
+			{FieldName: "incineration", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{FieldName: "improved_flamestrike", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 3},
+			{FieldName: "pyroblast", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{FieldName: "burning_soul", Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 2},
+			{FieldName: "improved_scorch", Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 2},
+			{FieldName: "master_of_elements", Location: TalentLocation{Row: 3, Col: 3}, MaxPoints: 3},
+			{FieldName: "playing_with_fire", Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 3},
+			{FieldName: "critical_mass", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 3},
+			{FieldName: "blast_wave", Location: TalentLocation{Row: 4, Col: 2}, PrereqLocation: &TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "fire_power", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{FieldName: "pyromaniac", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "combustion", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "molten_fury", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 2},
+			{FieldName: "empowered_fireball", Location: TalentLocation{Row: 7, Col: 2}, MaxPoints: 5},
+			{FieldName: "dragons_breath", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Frost",
+		Talents: []Talent{
+			{Location: TalentLocation{Row: 0, Col: 0}, MaxPoints: 2},
+			{FieldName: "improved_frostbolt", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "elemental_precision", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 3},
+			{FieldName: "ice_shards", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 3}, MaxPoints: 3},
+			{FieldName: "piercing_ice", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 3},
+			{FieldName: "icy_veins", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{FieldName: "frost_channeling", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 3},
+			{FieldName: "shatter", Location: TalentLocation{Row: 3, Col: 2}, PrereqLocation: &TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 3},
+			{FieldName: "cold_snap", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "improved_cone_of_cold", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 3},
+			{FieldName: "ice_floes", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "winters_chill", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "arctic_winds", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 5},
+			{FieldName: "empowered_frostbolt", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "summon_water_elemental", Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+}
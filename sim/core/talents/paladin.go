@@ -0,0 +1,92 @@
+package talents
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func init() {
+	registerClass(proto.Class_ClassPaladin, func() googleProto.Message { return &proto.PaladinTalents{} }, paladinTalentTrees)
+}
+
+var paladinTalentTrees = []TalentTree{
+	{
+		Name: "Holy",
+		Talents: []Talent{
+			{FieldName: "divine_strength", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "divine_intellect", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{FieldName: "improved_seal_of_righteousness", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{FieldName: "healing_light", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 2},
+			{FieldName: "illumination", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 5},
+			{FieldName: "improved_blessing_of_wisdom", Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 3},
+			{FieldName: "divine_favor", Location: TalentLocation{Row: 4, Col: 1}, PrereqLocation: &TalentLocation{Row: 3, Col: 1}, MaxPoints: 1},
+			{FieldName: "sanctified_light", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 3},
+			{FieldName: "purifying_power", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "holy_power", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{FieldName: "lights_grace", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "holy_shock", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "holy_guidance", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "divine_illumination", Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Protection",
+		Talents: []Talent{
+			{Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "precision", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 3}, MaxPoints: 5},
+			{FieldName: "blessing_of_kings", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 2, Col: 2}, PrereqLocation: &TalentLocation{Row: 0, Col: 2}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "reckoning", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 5},
+			{FieldName: "sacred_duty", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "one_handed_weapon_specialization", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 6, Col: 0}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 5},
+			{FieldName: "combat_expertise", Location: TalentLocation{Row: 7, Col: 2}, MaxPoints: 5},
+			{FieldName: "avengers_shield", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Retribution",
+		Talents: []Talent{
+			{FieldName: "improved_blessing_of_might", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "benediction", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "improved_judgement", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 2},
+			{FieldName: "improved_seal_of_the_crusader", Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{FieldName: "vindication", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 3},
+			{FieldName: "conviction", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 5},
+			{FieldName: "seal_of_command", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 2},
+			{FieldName: "crusade", Location: TalentLocation{Row: 3, Col: 3}, MaxPoints: 3},
+			{FieldName: "two_handed_weapon_specialization", Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 3},
+			{FieldName: "sanctity_aura", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 1},
+			{FieldName: "improved_sanctity_aura", Location: TalentLocation{Row: 4, Col: 3}, PrereqLocation: &TalentLocation{Row: 4, Col: 2}, MaxPoints: 2},
+			{FieldName: "vengeance", Location: TalentLocation{Row: 5, Col: 1}, MaxPoints: 5},
+			{FieldName: "sanctified_judgement", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 3},
+			{FieldName: "sanctified_seals", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "fanaticism", Location: TalentLocation{Row: 7, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 5},
+			{FieldName: "crusader_strike", Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+}
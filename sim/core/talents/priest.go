@@ -0,0 +1,92 @@
+package talents
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func init() {
+	registerClass(proto.Class_ClassPriest, func() googleProto.Message { return &proto.PriestTalents{} }, priestTalentTrees)
+}
+
+var priestTalentTrees = []TalentTree{
+	{
+		Name: "Discipline",
+		Talents: []Talent{
+			{Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "wand_specialization", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "silent_resolve", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 1, Col: 3}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 3},
+			{FieldName: "inner_focus", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 1},
+			{FieldName: "meditation", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 3},
+			{FieldName: "mental_agility", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 3, Col: 3}, MaxPoints: 2},
+			{FieldName: "mental_strength", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 5},
+			{FieldName: "divine_spirit", Location: TalentLocation{Row: 4, Col: 2}, PrereqLocation: &TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{FieldName: "improved_divine_spirit", Location: TalentLocation{Row: 4, Col: 3}, PrereqLocation: &TalentLocation{Row: 4, Col: 2}, MaxPoints: 2},
+			{FieldName: "focused_power", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "force_of_will", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "power_infusion", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 5},
+			{FieldName: "enlightenment", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Holy",
+		Talents: []Talent{
+			{Location: TalentLocation{Row: 0, Col: 0}, MaxPoints: 2},
+			{FieldName: "holy_specialization", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "improved_renew", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{FieldName: "divine_fury", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{FieldName: "holy_nova", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{FieldName: "improved_healing", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 3},
+			{FieldName: "searing_light", Location: TalentLocation{Row: 3, Col: 2}, PrereqLocation: &TalentLocation{Row: 1, Col: 2}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "spiritual_guidance", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 5},
+			{FieldName: "surge_of_light", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "spiritual_healing", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "empowered_healing", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "circle_of_healing", Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Shadow",
+		Talents: []Talent{
+			{Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "shadow_affinity", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 3},
+			{FieldName: "improved_shadow_word_pain", Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 2},
+			{FieldName: "shadow_focus", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{FieldName: "improved_mind_blast", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 5},
+			{FieldName: "mind_flay", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 2},
+			{FieldName: "shadow_weaving", Location: TalentLocation{Row: 3, Col: 3}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 4, Col: 0}, PrereqLocation: &TalentLocation{Row: 2, Col: 0}, MaxPoints: 1},
+			{FieldName: "vampiric_embrace", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "improved_vampiric_embrace", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 2},
+			{FieldName: "focused_mind", Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "darkness", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{FieldName: "shadowform", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "shadow_power", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 5},
+			{FieldName: "misery", Location: TalentLocation{Row: 7, Col: 2}, MaxPoints: 5},
+			{FieldName: "vampiric_touch", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+		},
+	},
+}
@@ -0,0 +1,95 @@
+package talents
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func init() {
+	registerClass(proto.Class_ClassRogue, func() googleProto.Message { return &proto.RogueTalents{} }, rogueTalentTrees)
+}
+
+var rogueTalentTrees = []TalentTree{
+	{
+		Name: "Assassination",
+		Talents: []Talent{
+			{FieldName: "improved_eviscerate", Location: TalentLocation{Row: 0, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 2},
+			{FieldName: "malice", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "ruthlessness", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 3},
+			{FieldName: "murder", Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 2},
+			{FieldName: "puncturing_wounds", Location: TalentLocation{Row: 1, Col: 3}, MaxPoints: 3},
+			{FieldName: "relentless_strikes", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 1},
+			{FieldName: "improved_expose_armor", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 2},
+			{FieldName: "lethality", Location: TalentLocation{Row: 2, Col: 2}, PrereqLocation: &TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "vile_poisons", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 5},
+			{FieldName: "improved_poisons", Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{FieldName: "cold_blood", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 3},
+			{FieldName: "quick_recovery", Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 2},
+			{FieldName: "seal_fate", Location: TalentLocation{Row: 5, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 5},
+			{FieldName: "master_poisoner", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 2},
+			{FieldName: "vigor", Location: TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 5},
+			{FieldName: "find_weakness", Location: TalentLocation{Row: 7, Col: 2}, MaxPoints: 5},
+			{FieldName: "mutilate", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Combat",
+		Talents: []Talent{
+			{Location: TalentLocation{Row: 0, Col: 0}, MaxPoints: 3},
+			{FieldName: "improved_sinister_strike", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "improved_slice_and_dice", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{FieldName: "precision", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 2, Col: 1}, PrereqLocation: &TalentLocation{Row: 1, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{FieldName: "dagger_specialization", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 5},
+			{FieldName: "dual_wield_specialization", Location: TalentLocation{Row: 3, Col: 2}, PrereqLocation: &TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{FieldName: "mace_specialization", Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 5},
+			{FieldName: "blade_flurry", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "sword_specialization", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 5},
+			{FieldName: "fist_weapon_specialization", Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "weapon_expertise", Location: TalentLocation{Row: 5, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 2},
+			{FieldName: "aggression", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 3},
+			{FieldName: "vitality", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 2},
+			{FieldName: "adrenaline_rush", Location: TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 2},
+			{FieldName: "combat_potency", Location: TalentLocation{Row: 7, Col: 2}, MaxPoints: 5},
+			{FieldName: "surprise_attacks", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Subtlety",
+		Talents: []Talent{
+			{Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "opportunity", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{FieldName: "initiative", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 3},
+			{FieldName: "ghostly_strike", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 1},
+			{FieldName: "improved_ambush", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 2},
+			{FieldName: "serrated_blades", Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{FieldName: "preparation", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "dirty_deeds", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 2},
+			{FieldName: "hemorrhage", Location: TalentLocation{Row: 4, Col: 3}, PrereqLocation: &TalentLocation{Row: 3, Col: 2}, MaxPoints: 1},
+			{FieldName: "master_of_subtlety", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 3},
+			{FieldName: "deadliness", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "premeditation", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "sinister_calling", Location: TalentLocation{Row: 7, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+			{FieldName: "shadowstep", Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+}
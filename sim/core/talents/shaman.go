@@ -0,0 +1,89 @@
+package talents
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func init() {
+	registerClass(proto.Class_ClassShaman, func() googleProto.Message { return &proto.ShamanTalents{} }, shamanTalentTrees)
+}
+
+var shamanTalentTrees = []TalentTree{
+	{
+		Name: "Elemental",
+		Talents: []Talent{
+			{FieldName: "convection", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "concussion", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 3},
+			{FieldName: "call_of_flame", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 3},
+			{FieldName: "elemental_focus", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 1},
+			{FieldName: "reverberation", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 5},
+			{FieldName: "call_of_thunder", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 5},
+			{FieldName: "improved_fire_totems", Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 3},
+			{FieldName: "elemental_devastation", Location: TalentLocation{Row: 3, Col: 3}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{FieldName: "elemental_fury", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "unrelenting_storm", Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 5},
+			{FieldName: "elemental_precision", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 3},
+			{FieldName: "lightning_mastery", Location: TalentLocation{Row: 5, Col: 2}, PrereqLocation: &TalentLocation{Row: 2, Col: 2}, MaxPoints: 5},
+			{FieldName: "elemental_mastery", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "lightning_overload", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "totemOfWrath", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 7, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Enhancement",
+		Talents: []Talent{
+			{FieldName: "ancestral_knowledge", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 2},
+			{FieldName: "thundering_strikes", Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 3}, MaxPoints: 3},
+			{FieldName: "enhancing_totems", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{FieldName: "shamanistic_focus", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 5},
+			{FieldName: "flurry", Location: TalentLocation{Row: 3, Col: 1}, PrereqLocation: &TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 5},
+			{FieldName: "improved_weapon_totems", Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "elemental_weapons", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 3},
+			{FieldName: "mental_quickness", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 3},
+			{FieldName: "weapon_mastery", Location: TalentLocation{Row: 5, Col: 3}, MaxPoints: 5},
+			{FieldName: "dual_wield_specialization", Location: TalentLocation{Row: 6, Col: 0}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "stormstrike", Location: TalentLocation{Row: 6, Col: 2}, PrereqLocation: &TalentLocation{Row: 4, Col: 2}, MaxPoints: 1},
+			{FieldName: "unleashed_rage", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "shamanistic_rage", Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Restoration",
+		Talents: []Talent{
+			{Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 3},
+			{FieldName: "totemic_focus", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{FieldName: "natures_guidance", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 3},
+			{FieldName: "restorative_totems", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 5},
+			{FieldName: "tidal_mastery", Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 3},
+			{FieldName: "natures_swiftness", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 3},
+			{FieldName: "purification", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{FieldName: "mana_tide_totem", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 3, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 5},
+			{FieldName: "natures_blessing", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 3},
+			{FieldName: "improved_chain_heal", Location: TalentLocation{Row: 7, Col: 2}, MaxPoints: 2},
+			{FieldName: "earth_shield", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 7, Col: 1}, MaxPoints: 1},
+		},
+	},
+}
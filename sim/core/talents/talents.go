@@ -0,0 +1,350 @@
+// Package talents converts between talent calculator strings and the
+// per-class talents protos.
+//
+// A talent string has one group of digits per tree, separated by '-'. Each
+// digit is the number of points in one talent, in the same order as the
+// Talents list of the tree. Trailing zeros and trailing empty trees may be
+// omitted, e.g. "2500250300030150330125--053500031003001".
+//
+// The tree layouts in this package must match the UI talent calculators in
+// ui/core/talents/.
+package talents
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const MaxTalentPoints = 61
+
+// Number of points which must be spent in a tree to unlock each row.
+const PointsPerRow = 5
+
+type TalentLocation struct {
+	Row int
+	Col int
+}
+
+type Talent struct {
+	// Name of the field in the class talents proto which holds this talent.
+	// Empty for talents the sim doesn't use; points in those talents are
+	// still counted, but aren't stored in the proto.
+	FieldName string
+
+	Location TalentLocation
+
+	// Location of a talent which must have all its points before this one can
+	// be learned, if any.
+	PrereqLocation *TalentLocation
+
+	MaxPoints int32
+}
+
+type TalentTree struct {
+	Name    string
+	Talents []Talent
+}
+
+type classTalents struct {
+	newProto func() googleProto.Message
+	trees    []TalentTree
+}
+
+var classTalentsByClass = map[proto.Class]classTalents{}
+
+func registerClass(class proto.Class, newProto func() googleProto.Message, trees []TalentTree) {
+	if _, ok := classTalentsByClass[class]; ok {
+		panic("Already registered talents for class: " + class.String())
+	}
+	classTalentsByClass[class] = classTalents{
+		newProto: newProto,
+		trees:    trees,
+	}
+}
+
+func getClassTalents(class proto.Class) (classTalents, error) {
+	ct, ok := classTalentsByClass[class]
+	if !ok {
+		return classTalents{}, fmt.Errorf("no talents for class %s", class)
+	}
+	return ct, nil
+}
+
+// Returns the talent trees for the given class, or nil if the class is unknown.
+func GetTalentTrees(class proto.Class) []TalentTree {
+	return classTalentsByClass[class].trees
+}
+
+// Returns an empty talents proto for the given class, e.g. *proto.MageTalents.
+func NewTalentsProto(class proto.Class) (googleProto.Message, error) {
+	ct, err := getClassTalents(class)
+	if err != nil {
+		return nil, err
+	}
+	return ct.newProto(), nil
+}
+
+// Splits a talent string into the number of points in each talent, indexed by
+// tree and then by talent. Missing trees and talents are filled with 0.
+func ParsePoints(class proto.Class, talentsString string) ([][]int32, error) {
+	ct, err := getClassTalents(class)
+	if err != nil {
+		return nil, err
+	}
+
+	treeStrings := strings.Split(talentsString, "-")
+	if len(treeStrings) > len(ct.trees) {
+		return nil, fmt.Errorf("talent string %q has %d trees, %s only has %d", talentsString, len(treeStrings), class, len(ct.trees))
+	}
+
+	points := make([][]int32, len(ct.trees))
+	for treeIdx, tree := range ct.trees {
+		points[treeIdx] = make([]int32, len(tree.Talents))
+		if treeIdx >= len(treeStrings) {
+			continue
+		}
+
+		treeString := treeStrings[treeIdx]
+		if len(treeString) > len(tree.Talents) {
+			return nil, fmt.Errorf("talent string %q has %d talents in the %s tree, which only has %d", talentsString, len(treeString), tree.Name, len(tree.Talents))
+		}
+		for talentIdx, c := range treeString {
+			if c < '0' || c > '9' {
+				return nil, fmt.Errorf("invalid character %q in talent string %q", c, talentsString)
+			}
+			points[treeIdx][talentIdx] = int32(c - '0')
+		}
+	}
+	return points, nil
+}
+
+// Formats points (indexed by tree and then by talent) as a talent string.
+func FormatPoints(points [][]int32) string {
+	treeStrings := make([]string, len(points))
+	for treeIdx, treePoints := range points {
+		var sb strings.Builder
+		for _, p := range treePoints {
+			sb.WriteString(fmt.Sprintf("%d", p))
+		}
+		treeStrings[treeIdx] = strings.TrimRight(sb.String(), "0")
+	}
+	return strings.TrimRight(strings.Join(treeStrings, "-"), "-")
+}
+
+// Checks that points (indexed by tree and then by talent) could have been
+// spent in-game: no talent above its max rank, no talent learned before its
+// row is unlocked or before its prerequisite is full, and no more than
+// MaxTalentPoints in total.
+func ValidatePoints(class proto.Class, points [][]int32) error {
+	ct, err := getClassTalents(class)
+	if err != nil {
+		return err
+	}
+	if len(points) != len(ct.trees) {
+		return fmt.Errorf("expected %d trees for %s, got %d", len(ct.trees), class, len(points))
+	}
+
+	totalPoints := int32(0)
+	for treeIdx, tree := range ct.trees {
+		treePoints := points[treeIdx]
+		if len(treePoints) != len(tree.Talents) {
+			return fmt.Errorf("expected %d talents in the %s tree, got %d", len(tree.Talents), tree.Name, len(treePoints))
+		}
+
+		// Number of points spent in each row of this tree.
+		pointsByRow := map[int]int32{}
+		for talentIdx, talent := range tree.Talents {
+			pointsByRow[talent.Location.Row] += treePoints[talentIdx]
+		}
+
+		for talentIdx, talent := range tree.Talents {
+			talentPoints := treePoints[talentIdx]
+			totalPoints += talentPoints
+			if talentPoints == 0 {
+				continue
+			}
+			if talentPoints < 0 || talentPoints > talent.MaxPoints {
+				return fmt.Errorf("%s talent at row %d, column %d has %d points, max is %d", tree.Name, talent.Location.Row+1, talent.Location.Col+1, talentPoints, talent.MaxPoints)
+			}
+
+			pointsInPreviousRows := int32(0)
+			for row := 0; row < talent.Location.Row; row++ {
+				pointsInPreviousRows += pointsByRow[row]
+			}
+			if pointsInPreviousRows < int32(talent.Location.Row*PointsPerRow) {
+				return fmt.Errorf("%s talent at row %d, column %d requires %d points in earlier rows, only %d spent", tree.Name, talent.Location.Row+1, talent.Location.Col+1, talent.Location.Row*PointsPerRow, pointsInPreviousRows)
+			}
+
+			if talent.PrereqLocation != nil {
+				prereqIdx := tree.findTalent(*talent.PrereqLocation)
+				if treePoints[prereqIdx] < tree.Talents[prereqIdx].MaxPoints {
+					return fmt.Errorf("%s talent at row %d, column %d requires the talent at row %d, column %d to be full", tree.Name, talent.Location.Row+1, talent.Location.Col+1, talent.PrereqLocation.Row+1, talent.PrereqLocation.Col+1)
+				}
+			}
+		}
+	}
+
+	if totalPoints > MaxTalentPoints {
+		return fmt.Errorf("%d talent points spent, max is %d", totalPoints, MaxTalentPoints)
+	}
+	return nil
+}
+
+// Returns the index of the talent at the given location.
+func (tree TalentTree) findTalent(location TalentLocation) int {
+	for i, talent := range tree.Talents {
+		if talent.Location == location {
+			return i
+		}
+	}
+	panic(fmt.Sprintf("No talent at row %d, column %d in %s tree", location.Row, location.Col, tree.Name))
+}
+
+// Decodes a talent string into the talents proto for the given class. Returns
+// an error if the string is malformed or fails ValidatePoints().
+func FromString(class proto.Class, talentsString string) (googleProto.Message, error) {
+	points, err := ParsePoints(class, talentsString)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidatePoints(class, points); err != nil {
+		return nil, fmt.Errorf("invalid talent string %q: %s", talentsString, err)
+	}
+
+	ct, _ := getClassTalents(class)
+	talents := ct.newProto()
+	msg := talents.ProtoReflect()
+	for treeIdx, tree := range ct.trees {
+		for talentIdx, talent := range tree.Talents {
+			if talent.FieldName == "" || points[treeIdx][talentIdx] == 0 {
+				continue
+			}
+			fd := getField(msg, talent)
+			if fd.Kind() == protoreflect.BoolKind {
+				msg.Set(fd, protoreflect.ValueOfBool(true))
+			} else {
+				msg.Set(fd, protoreflect.ValueOfInt32(points[treeIdx][talentIdx]))
+			}
+		}
+	}
+	return talents, nil
+}
+
+// Encodes a talents proto as a talent string. Talents which the sim doesn't
+// use are always encoded as 0, so only max ranks are checked here; the row and
+// prerequisite rules may depend on talents which aren't in the proto.
+func ToString(class proto.Class, talents googleProto.Message) (string, error) {
+	ct, err := getClassTalents(class)
+	if err != nil {
+		return "", err
+	}
+
+	msg := talents.ProtoReflect()
+	if msg.Descriptor() != ct.newProto().ProtoReflect().Descriptor() {
+		return "", fmt.Errorf("%s is not the talents proto for %s", msg.Descriptor().FullName(), class)
+	}
+
+	points := make([][]int32, len(ct.trees))
+	for treeIdx, tree := range ct.trees {
+		points[treeIdx] = make([]int32, len(tree.Talents))
+		for talentIdx, talent := range tree.Talents {
+			if talent.FieldName == "" {
+				continue
+			}
+			fd := getField(msg, talent)
+			if fd.Kind() == protoreflect.BoolKind {
+				if msg.Get(fd).Bool() {
+					points[treeIdx][talentIdx] = talent.MaxPoints
+				}
+			} else {
+				talentPoints := int32(msg.Get(fd).Int())
+				if talentPoints < 0 || talentPoints > talent.MaxPoints {
+					return "", fmt.Errorf("%s has %d points, max is %d", talent.FieldName, talentPoints, talent.MaxPoints)
+				}
+				points[treeIdx][talentIdx] = talentPoints
+			}
+		}
+	}
+	return FormatPoints(points), nil
+}
+
+func getField(msg protoreflect.Message, talent Talent) protoreflect.FieldDescriptor {
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(talent.FieldName))
+	if fd == nil {
+		panic(fmt.Sprintf("No field %s in %s", talent.FieldName, msg.Descriptor().FullName()))
+	}
+	return fd
+}
+
+// Returns the player's spec proto (e.g. player.Mage) and its talents field.
+func getSpecTalentsField(player *proto.Player) (protoreflect.FieldDescriptor, protoreflect.Message, protoreflect.FieldDescriptor, error) {
+	playerMsg := player.ProtoReflect()
+	specField := playerMsg.WhichOneof(playerMsg.Descriptor().Oneofs().ByName("spec"))
+	if specField == nil {
+		return nil, nil, nil, fmt.Errorf("player has no spec")
+	}
+
+	specMsg := playerMsg.Get(specField).Message()
+	talentsField := specMsg.Descriptor().Fields().ByName("talents")
+	if talentsField == nil {
+		return nil, nil, nil, fmt.Errorf("spec %s has no talents", specField.Name())
+	}
+	return specField, specMsg, talentsField, nil
+}
+
+// Decodes player.TalentsString into the talents of the player's spec proto.
+// The spec proto is replaced with a copy rather than modified, because it is
+// often shared with the request the player came from.
+func ApplyTalentsString(player *proto.Player) error {
+	talents, err := FromString(player.Class, player.TalentsString)
+	if err != nil {
+		return err
+	}
+
+	specField, specMsg, talentsField, err := getSpecTalentsField(player)
+	if err != nil {
+		return err
+	}
+	if talentsField.Message() != talents.ProtoReflect().Descriptor() {
+		return fmt.Errorf("spec %s doesn't use %s talents", specMsg.Descriptor().Name(), player.Class)
+	}
+
+	newSpecMsg := googleProto.Clone(specMsg.Interface()).ProtoReflect()
+	newSpecMsg.Set(talentsField, protoreflect.ValueOfMessage(talents.ProtoReflect()))
+	player.ProtoReflect().Set(specField, protoreflect.ValueOfMessage(newSpecMsg))
+	return nil
+}
+
+// Like ApplyTalentsString(), but only if the player's spec proto doesn't
+// already have talents. This lets sim requests specify talents with only a
+// talent string.
+func ApplyTalentsStringIfMissing(player *proto.Player) error {
+	if player.TalentsString == "" {
+		return nil
+	}
+
+	_, specMsg, talentsField, err := getSpecTalentsField(player)
+	if err != nil {
+		return err
+	}
+	if specMsg.Has(talentsField) {
+		return nil
+	}
+	return ApplyTalentsString(player)
+}
+
+// Encodes the talents of the player's spec proto as a talent string.
+func GetTalentsString(player *proto.Player) (string, error) {
+	_, specMsg, talentsField, err := getSpecTalentsField(player)
+	if err != nil {
+		return "", err
+	}
+	if !specMsg.Has(talentsField) {
+		return "", nil
+	}
+	return ToString(player.Class, specMsg.Get(talentsField).Message().Interface())
+}
@@ -0,0 +1,107 @@
+package talents
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestMageTalentsRoundTrip(t *testing.T) {
+	talents, err := FromString(proto.Class_ClassMage, "2500250300030150330125--053500031003001")
+	if err != nil {
+		t.Fatalf("Failed to parse talents: %s", err)
+	}
+
+	mageTalents := talents.(*proto.MageTalents)
+	if mageTalents.ArcaneSubtlety != 2 || mageTalents.ArcaneFocus != 5 || mageTalents.ArcaneConcentration != 5 || mageTalents.ArcaneImpact != 3 {
+		t.Fatalf("Talents were not decoded correctly: %v", mageTalents)
+	}
+
+	// Magic Absorption isn't in the proto, so its points are lost.
+	str, err := ToString(proto.Class_ClassMage, talents)
+	if err != nil {
+		t.Fatalf("Failed to format talents: %s", err)
+	}
+	if expected := "2500050300030150330125--053500031003001"; str != expected {
+		t.Fatalf("Expected %s but got %s", expected, str)
+	}
+}
+
+func TestInvalidTalentStrings(t *testing.T) {
+	for _, str := range []string{"1-1-1-1", "25x", "250000000000000000000000000"} {
+		if _, err := FromString(proto.Class_ClassMage, str); err == nil {
+			t.Fatalf("Expected error for talent string %q", str)
+		}
+	}
+}
+
+func TestApplyTalentsString(t *testing.T) {
+	player := &proto.Player{
+		Class:         proto.Class_ClassShaman,
+		Spec:          &proto.Player_ElementalShaman{ElementalShaman: &proto.ElementalShaman{}},
+		TalentsString: "55003105100213351051--05105301005",
+	}
+	if err := ApplyTalentsString(player); err != nil {
+		t.Fatalf("Failed to apply talents: %s", err)
+	}
+	if player.GetElementalShaman().Talents.Convection != 5 {
+		t.Fatalf("Talents were not applied: %v", player.GetElementalShaman().Talents)
+	}
+
+	str, err := GetTalentsString(player)
+	if err != nil {
+		t.Fatalf("Failed to format talents: %s", err)
+	}
+	if expected := "55003105100013351051--00005300005"; str != expected {
+		t.Fatalf("Expected %s but got %s", expected, str)
+	}
+}
+
+func TestTalentValidation(t *testing.T) {
+	// Exactly 61 points.
+	if _, err := FromString(proto.Class_ClassMage, "2500250300030150330125--053500031003001"); err != nil {
+		t.Fatalf("Expected valid talent string, got error: %s", err)
+	}
+
+	for _, str := range []string{
+		// Max rank: Arcane Subtlety only has 2 ranks.
+		"3",
+		// Row requirement: Arcane Impact (row 3) with only 5 points above it.
+		"0500000300",
+		// Prerequisite: Arcane Power requires full Arcane Instability.
+		"25002503000301502301",
+		// 61 point cap.
+		"2500250300030150330125-2-053500031003001",
+	} {
+		if _, err := FromString(proto.Class_ClassMage, str); err == nil {
+			t.Fatalf("Expected validation error for talent string %q", str)
+		}
+	}
+}
+
+func TestApplyTalentsStringIfMissing(t *testing.T) {
+	existing := &proto.MageTalents{ArcaneSubtlety: 1}
+	player := &proto.Player{
+		Class:         proto.Class_ClassMage,
+		Spec:          &proto.Player_Mage{Mage: &proto.Mage{Talents: existing}},
+		TalentsString: "25",
+	}
+	if err := ApplyTalentsStringIfMissing(player); err != nil {
+		t.Fatalf("Failed to apply talents: %s", err)
+	}
+	if player.GetMage().Talents != existing {
+		t.Fatalf("Existing talents should not be replaced")
+	}
+
+	spec := &proto.Mage{}
+	player.Spec = &proto.Player_Mage{Mage: spec}
+	if err := ApplyTalentsStringIfMissing(player); err != nil {
+		t.Fatalf("Failed to apply talents: %s", err)
+	}
+	if player.GetMage().Talents.ArcaneFocus != 5 {
+		t.Fatalf("Talents were not applied: %v", player.GetMage().Talents)
+	}
+	if spec.Talents != nil {
+		t.Fatalf("Original spec proto should not be modified")
+	}
+}
@@ -0,0 +1,92 @@
+package talents
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func init() {
+	registerClass(proto.Class_ClassWarlock, func() googleProto.Message { return &proto.WarlockTalents{} }, warlockTalentTrees)
+}
+
+var warlockTalentTrees = []TalentTree{
+	{
+		Name: "Affliction",
+		Talents: []Talent{
+			{FieldName: "suppression", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "improved_corruption", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 2},
+			{FieldName: "improved_life_tap", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 2},
+			{FieldName: "soul_siphon", Location: TalentLocation{Row: 1, Col: 3}, MaxPoints: 2},
+			{FieldName: "improved_curse_of_agony", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 5},
+			{FieldName: "amplify_curse", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{FieldName: "nightfall", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 2},
+			{FieldName: "empowered_corruption", Location: TalentLocation{Row: 3, Col: 3}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 5},
+			{FieldName: "siphon_life", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 4, Col: 2}, PrereqLocation: &TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{FieldName: "shadow_mastery", Location: TalentLocation{Row: 5, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 5},
+			{FieldName: "contagion", Location: TalentLocation{Row: 6, Col: 1}, MaxPoints: 5},
+			{FieldName: "dark_pact", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 7, Col: 0}, MaxPoints: 2},
+			{FieldName: "malediction", Location: TalentLocation{Row: 7, Col: 2}, MaxPoints: 3},
+			{FieldName: "unstable_affliction", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Demonology",
+		Talents: []Talent{
+			{Location: TalentLocation{Row: 0, Col: 0}, MaxPoints: 2},
+			{FieldName: "improved_imp", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 3},
+			{FieldName: "demonic_embrace", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 2},
+			{FieldName: "improved_voidwalker", Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 3},
+			{FieldName: "fel_intellect", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 3},
+			{FieldName: "improved_succubus", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 1},
+			{FieldName: "fel_stamina", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 3},
+			{FieldName: "demonic_aegis", Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 1}, PrereqLocation: &TalentLocation{Row: 2, Col: 1}, MaxPoints: 2},
+			{FieldName: "unholy_power", Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 5},
+			{FieldName: "improved_enslave_demon", Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{FieldName: "demonic_sacrifice", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "master_conjuror", Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 2},
+			{FieldName: "mana_feed", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 3},
+			{FieldName: "master_demonologist", Location: TalentLocation{Row: 5, Col: 2}, PrereqLocation: &TalentLocation{Row: 3, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "soul_link", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "demonic_knowledge", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "demonic_tactics", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "summon_felguard", Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Destruction",
+		Talents: []Talent{
+			{FieldName: "improved_shadow_bolt", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "cataclysm", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{FieldName: "bane", Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{FieldName: "improved_firebolt", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{FieldName: "improved_lash_of_pain", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 2},
+			{FieldName: "devastation", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 5},
+			{FieldName: "shadowburn", Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 2},
+			{FieldName: "improved_searing_pain", Location: TalentLocation{Row: 3, Col: 3}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 4, Col: 0}, PrereqLocation: &TalentLocation{Row: 3, Col: 0}, MaxPoints: 2},
+			{FieldName: "improved_immolate", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 5},
+			{FieldName: "ruin", Location: TalentLocation{Row: 4, Col: 2}, PrereqLocation: &TalentLocation{Row: 2, Col: 2}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 3},
+			{FieldName: "emberstorm", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{FieldName: "backlash", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "conflagrate", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+			{FieldName: "soul_leech", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "shadow_and_flame", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "shadowfury", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 7, Col: 1}, MaxPoints: 1},
+		},
+	},
+}
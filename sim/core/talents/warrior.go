@@ -0,0 +1,94 @@
+package talents
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func init() {
+	registerClass(proto.Class_ClassWarrior, func() googleProto.Message { return &proto.WarriorTalents{} }, warriorTalentTrees)
+}
+
+var warriorTalentTrees = []TalentTree{
+	{
+		Name: "Arms",
+		Talents: []Talent{
+			{FieldName: "improved_heroic_strike", Location: TalentLocation{Row: 0, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "improved_rend", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 3},
+			{FieldName: "improved_charge", Location: TalentLocation{Row: 1, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{FieldName: "improved_thunder_clap", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 3},
+			{FieldName: "improved_overpower", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 2},
+			{FieldName: "anger_management", Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 1},
+			{FieldName: "deep_wounds", Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 3},
+			{FieldName: "two_handed_weapon_specialization", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 5},
+			{FieldName: "impale", Location: TalentLocation{Row: 3, Col: 2}, PrereqLocation: &TalentLocation{Row: 2, Col: 2}, MaxPoints: 2},
+			{FieldName: "poleaxe_specialization", Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 5},
+			{FieldName: "death_wish", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "mace_specialization", Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 5},
+			{FieldName: "sword_specialization", Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 3},
+			{FieldName: "improved_disciplines", Location: TalentLocation{Row: 5, Col: 3}, MaxPoints: 3},
+			{FieldName: "blood_frenzy", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 2},
+			{FieldName: "mortal_strike", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 2},
+			{FieldName: "improved_mortal_strike", Location: TalentLocation{Row: 7, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 5},
+			{FieldName: "endless_rage", Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Fury",
+		Talents: []Talent{
+			{FieldName: "booming_voice", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 5},
+			{FieldName: "cruelty", Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{FieldName: "unbridled_wrath", Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{FieldName: "improved_cleave", Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 2, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 3},
+			{FieldName: "commanding_presence", Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 5},
+			{FieldName: "dual_wield_specialization", Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 5},
+			{FieldName: "improved_execute", Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 5},
+			{FieldName: "improved_slam", Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{FieldName: "sweeping_strikes", Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "weapon_mastery", Location: TalentLocation{Row: 4, Col: 3}, MaxPoints: 2},
+			{FieldName: "improved_berserker_rage", Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 2},
+			{FieldName: "flurry", Location: TalentLocation{Row: 5, Col: 2}, PrereqLocation: &TalentLocation{Row: 3, Col: 2}, MaxPoints: 5},
+			{FieldName: "precision", Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "bloodthirst", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "improved_whirlwind", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 2},
+			{FieldName: "improved_berserker_stance", Location: TalentLocation{Row: 7, Col: 2}, MaxPoints: 5},
+			{FieldName: "rampage", Location: TalentLocation{Row: 8, Col: 1}, PrereqLocation: &TalentLocation{Row: 6, Col: 1}, MaxPoints: 1},
+		},
+	},
+	{
+		Name: "Protection",
+		Talents: []Talent{
+			{FieldName: "improved_bloodrage", Location: TalentLocation{Row: 0, Col: 0}, MaxPoints: 2},
+			{FieldName: "tactical_mastery", Location: TalentLocation{Row: 0, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 0, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 1}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 1, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 2, Col: 0}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 1}, PrereqLocation: &TalentLocation{Row: 1, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 2, Col: 2}, MaxPoints: 3},
+			{FieldName: "defiance", Location: TalentLocation{Row: 2, Col: 3}, MaxPoints: 3},
+			{FieldName: "improved_sunder_armor", Location: TalentLocation{Row: 3, Col: 0}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 1}, MaxPoints: 3},
+			{Location: TalentLocation{Row: 3, Col: 2}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 4, Col: 0}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{Location: TalentLocation{Row: 4, Col: 2}, MaxPoints: 2},
+			{Location: TalentLocation{Row: 5, Col: 0}, MaxPoints: 3},
+			{FieldName: "one_handed_weapon_specialization", Location: TalentLocation{Row: 5, Col: 2}, MaxPoints: 5},
+			{Location: TalentLocation{Row: 6, Col: 0}, MaxPoints: 3},
+			{FieldName: "shield_slam", Location: TalentLocation{Row: 6, Col: 1}, PrereqLocation: &TalentLocation{Row: 4, Col: 1}, MaxPoints: 1},
+			{FieldName: "focused_rage", Location: TalentLocation{Row: 6, Col: 2}, MaxPoints: 3},
+			{FieldName: "vitality", Location: TalentLocation{Row: 7, Col: 1}, MaxPoints: 5},
+			{FieldName: "devastate", Location: TalentLocation{Row: 8, Col: 1}, MaxPoints: 1},
+		},
+	},
+}
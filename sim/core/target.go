@@ -1,11 +1,11 @@
 package core
 
 import (
-	"math"
 	"strconv"
 	"time"
 
 	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
 )
 
 type Encounter struct {
@@ -13,6 +13,30 @@ type Encounter struct {
 	DurationVariation  time.Duration
 	executePhaseBegins time.Duration
 	Targets            []*Target
+
+	// Targets in the order the raid should kill them.
+	killOrder []*Target
+
+	// Fight phases, in order.
+	Phases []EncounterPhase
+
+	// Per-iteration phase state.
+	currentPhase    int
+	phaseStartTimes []time.Duration
+
+	// Periods during which all players must move.
+	Movements []MovementEvent
+
+	// Damage dealt to players, for healers to heal.
+	DamageIntake []DamageIntake
+
+	// Whether iterations end once all targets with health have died.
+	EndWhenTargetsDie bool
+
+	// Distance between ranged players and the targets, in yards.
+	Distance float64
+
+	timeToKill timeToKillMetrics
 }
 
 func NewEncounter(options proto.Encounter) Encounter {
@@ -21,6 +45,9 @@ func NewEncounter(options proto.Encounter) Encounter {
 		DurationVariation:  DurationFromSeconds(options.DurationVariation),
 		executePhaseBegins: DurationFromSeconds(options.Duration * (1 - options.ExecuteProportion)),
 		Targets:            []*Target{},
+		EndWhenTargetsDie:  options.EndWhenTargetsDie,
+		Distance:           options.Distance,
+		timeToKill:         newTimeToKillMetrics(),
 	}
 
 	for targetIndex, targetOptions := range options.Targets {
@@ -28,6 +55,20 @@ func NewEncounter(options proto.Encounter) Encounter {
 		encounter.Targets = append(encounter.Targets, target)
 	}
 
+	encounter.killOrder = newKillOrder(options.KillOrder, encounter.Targets)
+
+	for _, phaseOptions := range options.Phases {
+		encounter.Phases = append(encounter.Phases, NewEncounterPhase(*phaseOptions, len(encounter.Targets)))
+	}
+
+	for _, movementOptions := range options.Movements {
+		encounter.Movements = append(encounter.Movements, NewMovementEvent(*movementOptions))
+	}
+
+	for _, intakeOptions := range options.DamageIntake {
+		encounter.DamageIntake = append(encounter.DamageIntake, NewDamageIntake(*intakeOptions))
+	}
+
 	encounter.finalize()
 
 	return encounter
@@ -44,6 +85,12 @@ func (encounter *Encounter) doneIteration(simDuration time.Duration) {
 		target := encounter.Targets[i]
 		target.doneIteration(simDuration)
 	}
+
+	if encounter.hasHealth() {
+		if killTime, killed := encounter.killTime(); killed {
+			encounter.timeToKill.addKill(killTime)
+		}
+	}
 }
 
 func (encounter *Encounter) GetMetricsProto(numIterations int32) *proto.EncounterMetrics {
@@ -57,11 +104,16 @@ func (encounter *Encounter) GetMetricsProto(numIterations int32) *proto.Encounte
 		i++
 	}
 
+	if encounter.hasHealth() {
+		metrics.TimeToKill = encounter.timeToKill.ToProto()
+		metrics.KillChance = float64(encounter.timeToKill.numKills) / float64(numIterations)
+	}
+
 	return metrics
 }
 
 // Target is an enemy that can be the target of attacks/spells.
-// Currently targets are basically just lvl 73 target dummies.
+// Targets default to lvl 73 target dummies.
 type Target struct {
 	// Index of this target among all the targets. Primary target has index 0,
 	// 2nd target has index 1, etc.
@@ -71,16 +123,28 @@ type Target struct {
 	currentArmor         float64 // current armor, can be mutated by spells
 	armorDamageReduction float64 // cached armor damage reduction
 
-	MissChance      float64
-	HitSuppression  float64
-	CritSuppression float64
-	Dodge           float64
-	Glance          float64
+	// Resistance to each magic school, indexed by the school's spell power stat
+	// (e.g. stats.FireSpellPower). Current values can be mutated by spells.
+	initialResistances stats.Stats
+	currentResistances stats.Stats
 
 	Level int32 // level of target
 
 	MobType proto.MobType
 
+	// Whether this target can currently be attacked. Inactive targets are
+	// despawned, untargetable or immune, and take no damage.
+	active bool
+
+	// Multiplier on all damage taken, set by the current encounter phase.
+	DamageTakenMultiplier float64
+
+	// Max health, or 0 if this target can't die.
+	MaxHealth     float64
+	currentHealth float64
+	dead          bool
+	deathTime     time.Duration
+
 	// Provides aura tracking behavior. Targets need auras to handle debuffs.
 	auraTracker
 
@@ -91,6 +155,12 @@ type Target struct {
 	// For logging.
 	Name string
 
+	// Damage taken from the raid, for metrics.
+	damageTaken         DistributionMetrics
+	damageByPlayer      map[int32]float64 // raid index to total damage, across all iterations
+	damageTakenBySource map[damageSourceKey]*DamageTakenMetrics
+	overkill            float64 // total across all iterations
+
 	// Cached value to handle sunder/expose overriding each other.
 	sunderOrExposeArmorReduction float64
 }
@@ -103,19 +173,21 @@ func NewTarget(options proto.Target, targetIndex int32) *Target {
 		auraTracker:  newAuraTracker(true),
 		Name:         "Target " + strconv.Itoa(int(targetIndex)+1),
 		Level:        73,
+		MaxHealth:    options.Health,
+
+		damageTaken:         NewDistributionMetrics(),
+		damageByPlayer:      make(map[int32]float64),
+		damageTakenBySource: make(map[damageSourceKey]*DamageTakenMetrics),
 	}
 	if target.currentArmor == 0 {
 		target.currentArmor = 7700
 	}
+	target.currentResistances[stats.ArcaneSpellPower] = options.ArcaneResistance
+	target.currentResistances[stats.FireSpellPower] = options.FireResistance
+	target.currentResistances[stats.FrostSpellPower] = options.FrostResistance
+	target.currentResistances[stats.NatureSpellPower] = options.NatureResistance
+	target.currentResistances[stats.ShadowSpellPower] = options.ShadowResistance
 	target.calculateReduction()
-	const skill = 350.0
-	skillDifference := float64(target.Level*5) - skill
-
-	target.MissChance = 0.05 + skillDifference*0.002
-	target.HitSuppression = (skillDifference - 10) * 0.002
-	target.CritSuppression = (skillDifference * 0.002) + 0.018
-	target.Dodge = 0.05 + skillDifference*0.001
-	target.Glance = math.Max(0.06+skillDifference*0.012, 0)
 
 	if options.Level > 0 {
 		target.Level = options.Level
@@ -139,28 +211,74 @@ func (target *Target) finalize() {
 	target.finalized = true
 
 	target.initialArmor = target.currentArmor
+	target.initialResistances = target.currentResistances
 	target.auraTracker.finalize()
 }
 
 func (target *Target) Reset(sim *Simulation) {
 	target.currentArmor = target.initialArmor
+	target.currentResistances = target.initialResistances
+	target.active = true
+	target.DamageTakenMultiplier = 1
+	target.currentHealth = target.MaxHealth
+	target.dead = false
+	target.deathTime = 0
+	target.damageTaken.reset()
 	target.auraTracker.reset(sim)
 	// Reset after removing any auras above
 	target.calculateReduction()
 }
 
+// Whether this target can currently be attacked.
+func (target *Target) IsActive() bool {
+	return target.active
+}
+
 func (target *Target) Advance(sim *Simulation, elapsedTime time.Duration) {
 	target.auraTracker.advance(sim)
 }
 
 func (target *Target) doneIteration(simDuration time.Duration) {
+	target.damageTaken.doneIteration(simDuration.Seconds())
 	target.auraTracker.doneIteration(simDuration)
 }
 
 func (target *Target) GetMetricsProto(numIterations int32) *proto.TargetMetrics {
-	return &proto.TargetMetrics{
-		Auras: target.auraTracker.GetMetricsProto(numIterations),
+	damageByPlayer := make(map[int32]float64, len(target.damageByPlayer))
+	for raidIndex, damage := range target.damageByPlayer {
+		damageByPlayer[raidIndex] = damage / float64(numIterations)
+	}
+
+	metrics := &proto.TargetMetrics{
+		Auras:             target.auraTracker.GetMetricsProto(numIterations),
+		DpsTaken:          target.damageTaken.ToProto(numIterations),
+		DamageByPlayerAvg: damageByPlayer,
+		OverkillAvg:       target.overkill / float64(numIterations),
+	}
+	for _, damageTakenMetrics := range target.damageTakenBySource {
+		metrics.DamageTaken = append(metrics.DamageTaken, damageTakenMetrics.ToProto(numIterations))
 	}
+	return metrics
+}
+
+func (target *Target) addDamageTaken(raidIndex int32, actionID ActionID, school stats.Stat, damage float64) {
+	key := damageSourceKey{
+		raidIndex: raidIndex,
+		actionKey: NewActionKey(actionID),
+		school:    school,
+	}
+	damageTakenMetrics, ok := target.damageTakenBySource[key]
+	if !ok {
+		damageTakenMetrics = &DamageTakenMetrics{
+			SourceRaidIndex: raidIndex,
+			ActionID:        actionID,
+			School:          school,
+		}
+		target.damageTakenBySource[key] = damageTakenMetrics
+	}
+
+	damageTakenMetrics.Hits++
+	damageTakenMetrics.Damage += damage
 }
 
 func (target *Target) calculateReduction() {
@@ -178,3 +296,51 @@ func (target *Target) ArmorDamageReduction(armorPen float64) float64 {
 	effectiveArmor := MaxFloat(0, target.currentArmor-armorPen)
 	return effectiveArmor / (effectiveArmor + 10557.5)
 }
+
+// Resistance to the given school, before spell penetration. Schools which
+// can't be resisted (holy, physical) always return 0.
+func (target *Target) Resistance(school stats.Stat) float64 {
+	resistance := target.currentResistances[school]
+	switch school {
+	case stats.ArcaneSpellPower, stats.ShadowSpellPower:
+		if target.HasAura(CurseOfElementsDebuffID) {
+			resistance -= curseResistanceReduction
+		}
+		if target.HasAura(CurseOfShadowDebuffID) {
+			resistance -= curseResistanceReduction
+		}
+	case stats.FireSpellPower, stats.FrostSpellPower:
+		if target.HasAura(CurseOfElementsDebuffID) {
+			resistance -= curseResistanceReduction
+		}
+	}
+	return resistance
+}
+
+func (target *Target) AddResistance(school stats.Stat, value float64) {
+	target.currentResistances[school] += value
+}
+
+// Average fraction of spell damage resisted due to the target's level, for
+// each level it has above the caster. This is what produces partial resists
+// against bosses with no resistances.
+const LevelBasedAverageResistPerLevel = 0.02
+
+// Returns the combat table for an attacker with the given weapon skill against this target.
+func (target *Target) AttackTable(weaponSkill float64) AttackTable {
+	return NewAttackTable(CharacterLevel, weaponSkill, target.Level)
+}
+
+// Base chance for a spell to hit this target, before hit rating.
+func (target *Target) SpellHitChance() float64 {
+	return SpellHitChance(CharacterLevel, target.Level)
+}
+
+// Average fraction of damage from the given school that this target resists,
+// from both its level and its resistances. Resistance below 0 after spell
+// penetration has no effect.
+func (target *Target) AverageResist(school stats.Stat, spellPenetration float64) float64 {
+	levelBasedResist := LevelBasedAverageResistPerLevel * float64(MaxInt32(0, target.Level-CharacterLevel))
+	effectiveResistance := MaxFloat(0, target.Resistance(school)-spellPenetration)
+	return MinFloat(0.75, levelBasedResist+0.75*effectiveResistance/(CharacterLevel*5))
+}
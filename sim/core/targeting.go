@@ -0,0 +1,56 @@
+package core
+
+import (
+	"fmt"
+)
+
+// Returns the targets in the order the raid should kill them. Targets listed
+// in killOrder come first, followed by the rest in index order.
+func newKillOrder(killOrder []int32, targets []*Target) []*Target {
+	ordered := make([]*Target, 0, len(targets))
+	listed := make([]bool, len(targets))
+
+	for _, targetIndex := range killOrder {
+		if targetIndex < 0 || int(targetIndex) >= len(targets) || listed[targetIndex] {
+			panic(fmt.Sprintf("Invalid kill order target index %d", targetIndex))
+		}
+		listed[targetIndex] = true
+		ordered = append(ordered, targets[targetIndex])
+	}
+
+	for i, target := range targets {
+		if !listed[i] {
+			ordered = append(ordered, target)
+		}
+	}
+
+	return ordered
+}
+
+// Returns the first target in the kill order which can currently be attacked.
+// If no targets are active, returns the first target.
+func (sim *Simulation) GetPrimaryTarget() *Target {
+	for _, target := range sim.encounter.killOrder {
+		if target.active {
+			return target
+		}
+	}
+	return sim.GetTarget(0)
+}
+
+// Returns the target this character should attack: its assigned target while
+// that target can be attacked, and otherwise the raid's primary target.
+func (character *Character) CurrentTarget(sim *Simulation) *Target {
+	if character.assignedTarget >= 0 {
+		if target := sim.GetTarget(character.assignedTarget); target.active {
+			return target
+		}
+	}
+	return sim.GetPrimaryTarget()
+}
+
+func (character *Character) validateTargetAssignment(numTargets int) {
+	if int(character.assignedTarget) >= numTargets {
+		panic(fmt.Sprintf("Invalid target assignment %d for %s", character.assignedTarget, character.Label))
+	}
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestKillOrderAndTargetAssignment(t *testing.T) {
+	encounter := NewEncounter(proto.Encounter{
+		Duration:  300,
+		KillOrder: []int32{2},
+		Targets:   []*proto.Target{{}, {}, {}},
+	})
+	sim := &Simulation{
+		encounter: encounter,
+	}
+	for _, target := range sim.encounter.Targets {
+		target.active = true
+	}
+
+	if primary := sim.GetPrimaryTarget(); primary != sim.GetTarget(2) {
+		t.Fatalf("Expected target 2 to be the primary target, got %d", primary.Index)
+	}
+
+	sim.GetTarget(2).active = false
+	if primary := sim.GetPrimaryTarget(); primary != sim.GetTarget(0) {
+		t.Fatalf("Expected target 0 to be the primary target, got %d", primary.Index)
+	}
+
+	character := &Character{assignedTarget: 1}
+	if target := character.CurrentTarget(sim); target != sim.GetTarget(1) {
+		t.Fatalf("Expected the assigned target, got %d", target.Index)
+	}
+
+	sim.GetTarget(1).active = false
+	if target := character.CurrentTarget(sim); target != sim.GetTarget(0) {
+		t.Fatalf("Expected the primary target once the assigned target is inactive, got %d", target.Index)
+	}
+}
@@ -419,6 +419,9 @@ type CharacterSuiteConfig struct {
 
 	StatsToWeigh    []proto.Stat
 	EPReferenceStat proto.Stat
+
+	// Optional. Damage taken by the raid in every encounter, for healing specs.
+	DamageIntake []*proto.DamageIntake
 }
 
 func FullCharacterTestSuiteGenerator(config CharacterSuiteConfig) TestGenerator {
@@ -438,6 +441,15 @@ func FullCharacterTestSuiteGenerator(config CharacterSuiteConfig) TestGenerator
 
 	defaultRaid := SinglePlayerRaidProto(defaultPlayer, config.PartyBuffs, config.RaidBuffs)
 
+	encounterCombos := MakeDefaultEncounterCombos(config.Debuffs)
+	singleTargetEncounter := MakeSingleTargetFullDebuffEncounter(config.Debuffs, 0)
+	averageEncounter := MakeSingleTargetFullDebuffEncounter(config.Debuffs, 5)
+	for _, encounterCombo := range encounterCombos {
+		encounterCombo.Encounter.DamageIntake = config.DamageIntake
+	}
+	singleTargetEncounter.DamageIntake = config.DamageIntake
+	averageEncounter.DamageIntake = config.DamageIntake
+
 	generator := &CombinedTestGenerator{
 		subgenerators: []SubGenerator{
 			SubGenerator{
@@ -468,7 +480,7 @@ func FullCharacterTestSuiteGenerator(config CharacterSuiteConfig) TestGenerator
 							Consumes: config.Consumes,
 						},
 					},
-					Encounters: MakeDefaultEncounterCombos(config.Debuffs),
+					Encounters: encounterCombos,
 					SimOptions: DefaultSimTestOptions,
 				},
 			},
@@ -478,7 +490,7 @@ func FullCharacterTestSuiteGenerator(config CharacterSuiteConfig) TestGenerator
 					Player:     defaultPlayer,
 					RaidBuffs:  config.RaidBuffs,
 					PartyBuffs: config.PartyBuffs,
-					Encounter:  MakeSingleTargetFullDebuffEncounter(config.Debuffs, 0),
+					Encounter:  singleTargetEncounter,
 					SimOptions: DefaultSimTestOptions,
 					ItemFilter: config.ItemFilter,
 				},
@@ -495,7 +507,7 @@ func FullCharacterTestSuiteGenerator(config CharacterSuiteConfig) TestGenerator
 					Player:     defaultPlayer,
 					RaidBuffs:  config.RaidBuffs,
 					PartyBuffs: config.PartyBuffs,
-					Encounter:  MakeSingleTargetFullDebuffEncounter(config.Debuffs, 0),
+					Encounter:  singleTargetEncounter,
 					SimOptions: StatWeightsDefaultSimTestOptions,
 
 					StatsToWeigh:    config.StatsToWeigh,
@@ -515,7 +527,7 @@ func FullCharacterTestSuiteGenerator(config CharacterSuiteConfig) TestGenerator
 				Name: "DPS",
 				Request: &proto.RaidSimRequest{
 					Raid:       newRaid,
-					Encounter:  MakeSingleTargetFullDebuffEncounter(config.Debuffs, 0),
+					Encounter:  singleTargetEncounter,
 					SimOptions: DefaultSimTestOptions,
 				},
 			},
@@ -530,7 +542,7 @@ func FullCharacterTestSuiteGenerator(config CharacterSuiteConfig) TestGenerator
 			Name: "Default",
 			Request: &proto.RaidSimRequest{
 				Raid:       defaultRaid,
-				Encounter:  MakeSingleTargetFullDebuffEncounter(config.Debuffs, 5),
+				Encounter:  averageEncounter,
 				SimOptions: AverageDefaultSimTestOptions,
 			},
 		},
@@ -56,8 +56,16 @@ func (testSuite *IndividualTestSuite) TestDPS(testName string, rsr *proto.RaidSi
 	result := RunRaidSim(rsr)
 	dps := result.RaidMetrics.Dps.Avg
 
+	hps := 0.0
+	for _, party := range result.RaidMetrics.Parties {
+		for _, player := range party.Players {
+			hps += player.GetHps().GetAvg()
+		}
+	}
+
 	testSuite.testResults.DpsResults[testName] = &proto.DpsTestResult{
 		Dps: dps,
+		Hps: hps,
 	}
 }
 
@@ -179,6 +187,10 @@ func RunTestSuite(t *testing.T, suiteName string, generator TestGenerator) {
 							t.Logf("DPS expected %0.03f but was %0.03f!.", expectedDpsResult.Dps, actualDpsResult.Dps)
 							t.Fail()
 						}
+						if actualDpsResult.Hps < expectedDpsResult.Hps-tolerance || actualDpsResult.Hps > expectedDpsResult.Hps+tolerance {
+							t.Logf("HPS expected %0.03f but was %0.03f!.", expectedDpsResult.Hps, actualDpsResult.Hps)
+							t.Fail()
+						}
 					} else {
 						t.Logf("Unexpected test %s with %0.03f DPS!", fullTestName, actualDpsResult.Dps)
 						t.Fail()
@@ -31,6 +31,16 @@ var AverageDefaultSimTestOptions = &proto.SimOptions{
 const ShortDuration = 60
 const LongDuration = 300
 
+// Steady damage on the raid, for testing healing specs.
+var DefaultDamageIntake = []*proto.DamageIntake{
+	&proto.DamageIntake{
+		Damage:          1200,
+		DamageVariation: 0.2,
+		StartTime:       1,
+		Interval:        2,
+	},
+}
+
 func MakeDefaultEncounterCombos(debuffs *proto.Debuffs) []EncounterCombo {
 	var NoDebuffTarget = &proto.Target{
 		Level:   73,
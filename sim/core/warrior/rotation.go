@@ -4,5 +4,8 @@ import (
 	"github.com/wowsims/tbc/sim/core"
 )
 
+// Warriors don't implement core.MovingAgent. All of their attacks need melee
+// range, so while moving they wait for the movement to end, and their auto
+// attacks are delayed until then.
 func (warrior *Warrior) OnGCDReady(sim *core.Simulation) {
 }
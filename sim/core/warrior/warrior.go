@@ -48,6 +48,7 @@ func (warrior *Warrior) Reset(newsim *core.Simulation) {
 
 func NewWarrior(character core.Character, options proto.Player) *Warrior {
 	warriorOptions := options.GetWarrior()
+	character.RejectAPLRotation("Warrior")
 
 	warrior := &Warrior{
 		Character:    character,
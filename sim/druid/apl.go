@@ -0,0 +1,66 @@
+package druid
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+)
+
+// Registers the actions which APL rotations can use.
+func (druid *Druid) registerAPLActions() {
+	druid.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDSF8},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return druid.NewStarfire(sim, target, 8).Cast(sim)
+		},
+	})
+	druid.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDSF6},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return druid.NewStarfire(sim, target, 6).Cast(sim)
+		},
+	})
+	druid.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDWrath},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return druid.NewWrath(sim, target).Cast(sim)
+		},
+	})
+	druid.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDMoonfire},
+		DotRemaining: func(sim *core.Simulation, target *core.Target) time.Duration {
+			return druid.MoonfireSpellOn(target).Effect.DotInput.TimeRemaining(sim)
+		},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			// The spell object is reused, so it can't be recast until the old DoT is done.
+			if druid.MoonfireSpellOn(target).IsInUse() {
+				return false
+			}
+			return druid.NewMoonfire(sim, target).Cast(sim)
+		},
+	})
+	druid.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDFaerieFire},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			if druid.FaerieFireSpell.IsInUse() {
+				return false
+			}
+			return druid.NewFaerieFire(sim, target).Cast(sim)
+		},
+	})
+
+	if druid.Talents.InsectSwarm {
+		druid.RegisterAPLAction(core.APLActionConfig{
+			ActionID: core.ActionID{SpellID: SpellIDInsectSwarm},
+			DotRemaining: func(sim *core.Simulation, target *core.Target) time.Duration {
+				return druid.InsectSwarmSpellOn(target).Effect.DotInput.TimeRemaining(sim)
+			},
+			Execute: func(sim *core.Simulation, target *core.Target) bool {
+				if druid.InsectSwarmSpellOn(target).IsInUse() {
+					return false
+				}
+				return druid.NewInsectSwarm(sim, target).Cast(sim)
+			},
+		})
+	}
+}
@@ -124,6 +124,11 @@ func (moonkin *BalanceDruid) OnManaTick(sim *core.Simulation) {
 }
 
 func (moonkin *BalanceDruid) tryUseGCD(sim *core.Simulation) {
+	if moonkin.HasAPLRotation() {
+		moonkin.DoAPLRotation(sim)
+		return
+	}
+
 	if moonkin.useSurplusRotation {
 		moonkin.manaTracker.Update(sim, moonkin.GetCharacter())
 
@@ -138,6 +143,31 @@ func (moonkin *BalanceDruid) tryUseGCD(sim *core.Simulation) {
 	}
 }
 
+// Starfire and Wrath can't be cast while moving, so refresh debuffs and dots
+// instead.
+func (moonkin *BalanceDruid) OnGCDReadyWhileMoving(sim *core.Simulation) {
+	target := moonkin.CurrentTarget(sim)
+
+	var spell *core.SimpleSpell
+	if moonkin.ShouldCastFaerieFire(sim, target, moonkin.primaryRotation) {
+		spell = moonkin.NewFaerieFire(sim, target)
+	} else if moonkin.Talents.InsectSwarm && !moonkin.InsectSwarmSpellOn(target).IsInUse() {
+		spell = moonkin.NewInsectSwarm(sim, target)
+	} else if !moonkin.MoonfireSpellOn(target).IsInUse() {
+		spell = moonkin.NewMoonfire(sim, target)
+	}
+
+	if spell == nil {
+		waitUntil := core.MinDuration(moonkin.MovementEndsAt(), sim.CurrentTime+moonkin.MoonfireSpellOn(target).Effect.DotInput.TimeRemaining(sim))
+		moonkin.WaitUntil(sim, core.MaxDuration(waitUntil, sim.CurrentTime+time.Millisecond*100))
+		return
+	}
+
+	if success := spell.Cast(sim); !success {
+		moonkin.WaitUntil(sim, moonkin.MovementEndsAt())
+	}
+}
+
 func (moonkin *BalanceDruid) actRotation(sim *core.Simulation, rotation proto.BalanceDruid_Rotation) {
 	// Activate shared druid behaviors
 	// Use Rebirth at the beginning of the fight if flagged in rotation settings
@@ -147,7 +177,7 @@ func (moonkin *BalanceDruid) actRotation(sim *core.Simulation, rotation proto.Ba
 		return
 	}
 
-	target := sim.GetPrimaryTarget()
+	target := moonkin.CurrentTarget(sim)
 
 	var spell *core.SimpleSpell
 
@@ -159,6 +189,8 @@ func (moonkin *BalanceDruid) actRotation(sim *core.Simulation, rotation proto.Ba
 		spell = moonkin.NewInsectSwarm(sim, target)
 	} else if moonkin.ShouldCastMoonfire(sim, target, rotation) {
 		spell = moonkin.NewMoonfire(sim, target)
+	} else if multiDotSpell := moonkin.newMultiDotSpell(sim, target, rotation); multiDotSpell != nil {
+		spell = multiDotSpell
 	} else {
 		switch rotation.PrimarySpell {
 		case proto.BalanceDruid_Rotation_Starfire:
@@ -175,6 +207,28 @@ func (moonkin *BalanceDruid) actRotation(sim *core.Simulation, rotation proto.Ba
 	}
 }
 
+// Returns an Insect Swarm or Moonfire for another active target which doesn't
+// have it yet, if multi-dotting is enabled.
+func (moonkin *BalanceDruid) newMultiDotSpell(sim *core.Simulation, currentTarget *core.Target, rotation proto.BalanceDruid_Rotation) *core.SimpleSpell {
+	if !rotation.MultiDot {
+		return nil
+	}
+
+	for i := int32(0); i < sim.GetNumTargets(); i++ {
+		target := sim.GetTarget(i)
+		if target == currentTarget || !target.IsActive() {
+			continue
+		}
+
+		if moonkin.ShouldCastInsectSwarm(sim, target, rotation) {
+			return moonkin.NewInsectSwarm(sim, target)
+		} else if moonkin.ShouldCastMoonfire(sim, target, rotation) {
+			return moonkin.NewMoonfire(sim, target)
+		}
+	}
+	return nil
+}
+
 // Returns the order of DPS rotations to try, from highest to lowest dps. The
 // lower DPS rotations are more mana efficient.
 //
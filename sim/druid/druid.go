@@ -21,13 +21,15 @@ type Druid struct {
 	starfire8CastTemplate core.SimpleSpellTemplate
 	starfire6CastTemplate core.SimpleSpellTemplate
 
-	MoonfireSpell        core.SimpleSpell
+	// One per target, so Moonfire can be kept up on several targets.
+	MoonfireSpells       []core.SimpleSpell
 	moonfireCastTemplate core.SimpleSpellTemplate
 
 	wrathSpell        core.SimpleSpell
 	wrathCastTemplate core.SimpleSpellTemplate
 
-	InsectSwarmSpell        core.SimpleSpell
+	// One per target, so Insect Swarm can be kept up on several targets.
+	InsectSwarmSpells       []core.SimpleSpell
 	insectSwarmCastTemplate core.SimpleSpellTemplate
 
 	FaerieFireSpell        core.SimpleSpell
@@ -35,6 +37,19 @@ type Druid struct {
 
 	HurricaneSpell        core.SimpleSpell
 	hurricaneCastTemplate core.SimpleSpellTemplate
+
+	// One per player, so hots can be kept up on several players.
+	LifebloomHeals         []core.SimpleHeal
+	lifebloomStacks        []int32
+	lifebloomCastTemplate  core.SimpleHealTemplate
+	lifebloomBloomSpell    core.SimpleHeal
+	lifebloomBloomTemplate core.SimpleHealTemplate
+
+	RejuvenationHeals        []core.SimpleHeal
+	rejuvenationCastTemplate core.SimpleHealTemplate
+
+	RegrowthHeals        []core.SimpleHeal
+	regrowthCastTemplate core.SimpleHealTemplate
 }
 
 type SelfBuffs struct {
@@ -72,10 +87,21 @@ func (druid *Druid) Init(sim *core.Simulation) {
 	druid.starfire8CastTemplate = druid.newStarfireTemplate(sim, 8)
 	druid.starfire6CastTemplate = druid.newStarfireTemplate(sim, 6)
 	druid.moonfireCastTemplate = druid.newMoonfireTemplate(sim)
+	druid.MoonfireSpells = make([]core.SimpleSpell, sim.GetNumTargets())
 	druid.wrathCastTemplate = druid.newWrathTemplate(sim)
 	druid.insectSwarmCastTemplate = druid.newInsectSwarmTemplate(sim)
+	druid.InsectSwarmSpells = make([]core.SimpleSpell, sim.GetNumTargets())
 	druid.faerieFireCastTemplate = druid.newFaerieFireTemplate(sim)
 	druid.hurricaneCastTemplate = druid.newHurricaneTemplate(sim)
+
+	druid.lifebloomCastTemplate = druid.newLifebloomTemplate(sim)
+	druid.lifebloomBloomTemplate = druid.newLifebloomBloomTemplate(sim)
+	druid.LifebloomHeals = newHealsByRaidIndex(sim)
+	druid.lifebloomStacks = make([]int32, len(druid.LifebloomHeals))
+	druid.rejuvenationCastTemplate = druid.newRejuvenationTemplate(sim)
+	druid.RejuvenationHeals = newHealsByRaidIndex(sim)
+	druid.regrowthCastTemplate = druid.newRegrowthTemplate(sim)
+	druid.RegrowthHeals = newHealsByRaidIndex(sim)
 }
 
 func (druid *Druid) Reset(sim *core.Simulation) {
@@ -121,6 +147,7 @@ func New(char core.Character, selfBuffs SelfBuffs, talents proto.DruidTalents) *
 	})
 
 	druid.registerInnervateCD()
+	druid.registerAPLActions()
 	druid.applyTalents()
 
 	return druid
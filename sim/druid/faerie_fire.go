@@ -5,11 +5,13 @@ import (
 	"github.com/wowsims/tbc/sim/core/proto"
 )
 
+const SpellIDFaerieFire int32 = 26993
+
 func (druid *Druid) newFaerieFireTemplate(sim *core.Simulation) core.SimpleSpellTemplate {
 	return core.NewSimpleSpellTemplate(core.SimpleSpell{
 		SpellCast: core.SpellCast{
 			Cast: core.Cast{
-				ActionID:     core.ActionID{SpellID: 26993},
+				ActionID:     core.ActionID{SpellID: SpellIDFaerieFire},
 				Character:    druid.GetCharacter(),
 				BaseManaCost: 145,
 				ManaCost:     145,
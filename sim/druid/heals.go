@@ -0,0 +1,64 @@
+package druid
+
+import (
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+// Helper for precomputing heal effects, with the talents shared by all druid heals.
+func (druid *Druid) newHealEffect() core.HealEffect {
+	effect := core.HealEffect{
+		HealingMultiplier: 1,
+	}
+
+	effect.HealingMultiplier *= 1 + 0.02*float64(druid.Talents.GiftOfNature)
+
+	return effect
+}
+
+// Precomputes the hot part of a heal, including Empowered Rejuvenation.
+func (druid *Druid) newHotInput(hotInput core.HotInput) core.HotInput {
+	hotInput.TickSpellCoefficient *= 1 + 0.04*float64(druid.Talents.EmpoweredRejuvenation)
+	return hotInput
+}
+
+// Heals are indexed by raid index, so 1 hot of each type can be kept up on
+// every player.
+func newHealsByRaidIndex(sim *core.Simulation) []core.SimpleHeal {
+	return make([]core.SimpleHeal, len(sim.Raid.Parties)*5)
+}
+
+var TreeOfLifeAuraID = core.NewAuraID()
+
+const SpellIDTreeOfLife int32 = 33891
+
+const treeOfLifeManaCostReduction = 0.2
+
+// Tree of Life is assumed to be active the whole fight. It increases healing
+// received by party members and reduces the mana cost of hots.
+func (druid *Druid) applyTreeOfLife() {
+	if !druid.Talents.TreeOfLife {
+		return
+	}
+
+	druid.AddPermanentAura(func(sim *core.Simulation) core.Aura {
+		return core.Aura{
+			ID:       TreeOfLifeAuraID,
+			ActionID: core.ActionID{SpellID: SpellIDTreeOfLife},
+			OnCast: func(sim *core.Simulation, cast *core.Cast) {
+				switch cast.ActionID.SpellID {
+				case SpellIDLifebloom, SpellIDRejuvenation, SpellIDRegrowth:
+					cast.ManaCost -= cast.BaseManaCost * treeOfLifeManaCostReduction
+				}
+			},
+		}
+	})
+}
+
+// Bonus healing power from Tree of Life, for a heal on the given target.
+func (druid *Druid) treeOfLifeBonus(target *core.Character) float64 {
+	if target == nil || target.Party != druid.Party || !druid.HasAura(TreeOfLifeAuraID) {
+		return 0
+	}
+	return druid.GetStat(stats.Spirit) * 0.25
+}
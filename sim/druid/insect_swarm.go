@@ -46,7 +46,7 @@ func (druid *Druid) newInsectSwarmTemplate(sim *core.Simulation) core.SimpleSpel
 
 func (druid *Druid) NewInsectSwarm(sim *core.Simulation, target *core.Target) *core.SimpleSpell {
 	// Initialize cast from precomputed template.
-	sf := &druid.InsectSwarmSpell
+	sf := druid.InsectSwarmSpellOn(target)
 	druid.insectSwarmCastTemplate.Apply(sf)
 
 	// Set dynamic fields, i.e. the stuff we couldn't precompute.
@@ -57,5 +57,10 @@ func (druid *Druid) NewInsectSwarm(sim *core.Simulation, target *core.Target) *c
 }
 
 func (druid *Druid) ShouldCastInsectSwarm(sim *core.Simulation, target *core.Target, rotation proto.BalanceDruid_Rotation) bool {
-	return rotation.InsectSwarm && !druid.InsectSwarmSpell.Effect.DotInput.IsTicking(sim)
+	return rotation.InsectSwarm && !druid.InsectSwarmSpellOn(target).Effect.DotInput.IsTicking(sim)
+}
+
+// Returns the Insect Swarm spell for the given target.
+func (druid *Druid) InsectSwarmSpellOn(target *core.Target) *core.SimpleSpell {
+	return &druid.InsectSwarmSpells[target.Index]
 }
@@ -63,8 +63,8 @@ var ItemSetNordrassil = core.ItemSet{
 						if spellCast.ActionID.SpellID == SpellIDSF8 || spellCast.ActionID.SpellID == SpellIDSF6 {
 							// Check if moonfire/insectswarm is ticking on the target.
 							// TODO: in a raid simulator we need to be able to see which dots are ticking from other druids.
-							if (druid.MoonfireSpell.Effect.DotInput.IsTicking(sim) && druid.MoonfireSpell.Effect.Target == spellEffect.Target) ||
-								(druid.InsectSwarmSpell.Effect.DotInput.IsTicking(sim) && druid.InsectSwarmSpell.Effect.Target == spellEffect.Target) {
+							if druid.MoonfireSpellOn(spellEffect.Target).Effect.DotInput.IsTicking(sim) ||
+								druid.InsectSwarmSpellOn(spellEffect.Target).Effect.DotInput.IsTicking(sim) {
 								spellEffect.DamageMultiplier *= 1.1
 							}
 						}
@@ -0,0 +1,131 @@
+package druid
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const SpellIDLifebloom int32 = 33763
+
+const lifebloomMaxStacks = 3
+const lifebloomManaCost = 220.0
+
+func (druid *Druid) newLifebloomTemplate(sim *core.Simulation) core.SimpleHealTemplate {
+	effect := druid.newHealEffect()
+	effect.HotInput = druid.newHotInput(core.HotInput{
+		NumberOfTicks:        7,
+		TickLength:           time.Second,
+		TickBaseHealing:      273.0 / 7,
+		TickSpellCoefficient: 0.518 / 7,
+	})
+
+	return core.NewSimpleHealTemplate(core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: core.Cast{
+				ActionID:       core.ActionID{SpellID: SpellIDLifebloom},
+				Character:      &druid.Character,
+				SpellSchool:    stats.NatureSpellPower,
+				BaseManaCost:   lifebloomManaCost,
+				ManaCost:       lifebloomManaCost,
+				GCD:            core.GCDDefault,
+				CritMultiplier: core.HealCritMultiplier,
+			},
+		},
+		Effect: effect,
+	})
+}
+
+// The heal when Lifebloom expires. This uses a separate tag so it shows up
+// separately from the hot in metrics.
+func (druid *Druid) newLifebloomBloomTemplate(sim *core.Simulation) core.SimpleHealTemplate {
+	effect := druid.newHealEffect()
+	effect.DirectInput = core.DirectHealInput{
+		MinBaseHealing:   600,
+		MaxBaseHealing:   600,
+		SpellCoefficient: 0.3429,
+	}
+	effect.IgnoreCritCheck = true
+
+	return core.NewSimpleHealTemplate(core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: core.Cast{
+				ActionID:       core.ActionID{SpellID: SpellIDLifebloom, Tag: 1},
+				Character:      &druid.Character,
+				SpellSchool:    stats.NatureSpellPower,
+				IgnoreManaCost: true,
+				IsPhantom:      true,
+				CritMultiplier: core.HealCritMultiplier,
+			},
+		},
+		Effect: effect,
+	})
+}
+
+// Casts Lifebloom on the target. If Lifebloom is already on the target, it
+// gains a stack (up to 3) and its duration is refreshed without blooming.
+//
+// Refreshing cancels the existing hot even if the new cast fails, so check
+// LifebloomManaCost() first.
+func (druid *Druid) NewLifebloom(sim *core.Simulation, target *core.Character) *core.SimpleHeal {
+	lb := druid.LifebloomOn(target)
+
+	stacks := int32(1)
+	if lb.Effect.HotInput.IsTicking(sim) {
+		stacks = core.MinInt32(druid.lifebloomStacks[target.RaidIndex]+1, lifebloomMaxStacks)
+		lb.Cancel(sim)
+	}
+
+	// Initialize cast from precomputed template.
+	druid.lifebloomCastTemplate.Apply(lb)
+
+	// Set dynamic fields, i.e. the stuff we couldn't precompute.
+	lb.Effect.Target = target
+	lb.Effect.BonusHealingPower += druid.treeOfLifeBonus(target)
+	lb.Effect.HotInput.TickBaseHealing *= float64(stacks)
+	lb.Effect.HotInput.TickSpellCoefficient *= float64(stacks)
+	lb.Effect.HotInput.OnExpire = func(sim *core.Simulation, spellCast *core.SpellCast, healEffect *core.HealEffect) {
+		druid.lifebloomBloom(sim, healEffect.Target, stacks)
+	}
+	lb.OnCastComplete = func(sim *core.Simulation, cast *core.Cast) {
+		druid.lifebloomStacks[target.RaidIndex] = stacks
+	}
+
+	lb.Init(sim)
+
+	return lb
+}
+
+func (druid *Druid) lifebloomBloom(sim *core.Simulation, target *core.Character, stacks int32) {
+	bloom := &druid.lifebloomBloomSpell
+	druid.lifebloomBloomTemplate.Apply(bloom)
+
+	bloom.Effect.Target = target
+	bloom.Effect.BonusHealingPower += druid.treeOfLifeBonus(target)
+	bloom.Effect.DirectInput.MinBaseHealing *= float64(stacks)
+	bloom.Effect.DirectInput.MaxBaseHealing *= float64(stacks)
+	bloom.Effect.DirectInput.SpellCoefficient *= float64(stacks)
+
+	bloom.Init(sim)
+	bloom.Cast(sim)
+}
+
+func (druid *Druid) LifebloomManaCost() float64 {
+	if druid.HasAura(TreeOfLifeAuraID) {
+		return lifebloomManaCost * (1 - treeOfLifeManaCostReduction)
+	}
+	return lifebloomManaCost
+}
+
+func (druid *Druid) LifebloomOn(target *core.Character) *core.SimpleHeal {
+	return &druid.LifebloomHeals[target.RaidIndex]
+}
+
+// Returns the number of Lifebloom stacks the druid has on the target.
+func (druid *Druid) LifebloomStacks(sim *core.Simulation, target *core.Character) int32 {
+	if !druid.LifebloomOn(target).Effect.HotInput.IsTicking(sim) {
+		return 0
+	}
+	return druid.lifebloomStacks[target.RaidIndex]
+}
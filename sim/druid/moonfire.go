@@ -65,7 +65,7 @@ func (druid *Druid) newMoonfireTemplate(sim *core.Simulation) core.SimpleSpellTe
 
 func (druid *Druid) NewMoonfire(sim *core.Simulation, target *core.Target) *core.SimpleSpell {
 	// Initialize cast from precomputed template.
-	sf := &druid.MoonfireSpell
+	sf := druid.MoonfireSpellOn(target)
 	druid.moonfireCastTemplate.Apply(sf)
 
 	// Set dynamic fields, i.e. the stuff we couldn't precompute.
@@ -76,5 +76,10 @@ func (druid *Druid) NewMoonfire(sim *core.Simulation, target *core.Target) *core
 }
 
 func (druid *Druid) ShouldCastMoonfire(sim *core.Simulation, target *core.Target, rotation proto.BalanceDruid_Rotation) bool {
-	return rotation.Moonfire && !druid.MoonfireSpell.Effect.DotInput.IsTicking(sim)
+	return rotation.Moonfire && !druid.MoonfireSpellOn(target).Effect.DotInput.IsTicking(sim)
+}
+
+// Returns the Moonfire spell for the given target.
+func (druid *Druid) MoonfireSpellOn(target *core.Target) *core.SimpleSpell {
+	return &druid.MoonfireSpells[target.Index]
 }
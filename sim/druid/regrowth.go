@@ -0,0 +1,59 @@
+package druid
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const SpellIDRegrowth int32 = 26980
+
+func (druid *Druid) newRegrowthTemplate(sim *core.Simulation) core.SimpleHealTemplate {
+	effect := druid.newHealEffect()
+	effect.DirectInput = core.DirectHealInput{
+		MinBaseHealing:   1253,
+		MaxBaseHealing:   1394,
+		SpellCoefficient: 0.286,
+	}
+	effect.HotInput = druid.newHotInput(core.HotInput{
+		NumberOfTicks:        7,
+		TickLength:           time.Second * 3,
+		TickBaseHealing:      1274.0 / 7,
+		TickSpellCoefficient: 0.7 / 7,
+	})
+	effect.BonusCritRating += float64(druid.Talents.ImprovedRegrowth) * 10 * core.SpellCritRatingPerCritChance
+
+	return core.NewSimpleHealTemplate(core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: core.Cast{
+				ActionID:       core.ActionID{SpellID: SpellIDRegrowth},
+				Character:      &druid.Character,
+				SpellSchool:    stats.NatureSpellPower,
+				BaseManaCost:   675,
+				ManaCost:       675,
+				CastTime:       time.Second * 2,
+				GCD:            core.GCDDefault,
+				CritMultiplier: core.HealCritMultiplier,
+			},
+		},
+		Effect: effect,
+	})
+}
+
+func (druid *Druid) NewRegrowth(sim *core.Simulation, target *core.Character) *core.SimpleHeal {
+	// Initialize cast from precomputed template.
+	regrowth := druid.RegrowthOn(target)
+	druid.regrowthCastTemplate.Apply(regrowth)
+
+	// Set dynamic fields, i.e. the stuff we couldn't precompute.
+	regrowth.Effect.Target = target
+	regrowth.Effect.BonusHealingPower += druid.treeOfLifeBonus(target)
+	regrowth.Init(sim)
+
+	return regrowth
+}
+
+func (druid *Druid) RegrowthOn(target *core.Character) *core.SimpleHeal {
+	return &druid.RegrowthHeals[target.RaidIndex]
+}
@@ -0,0 +1,53 @@
+package druid
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const SpellIDRejuvenation int32 = 26982
+
+func (druid *Druid) newRejuvenationTemplate(sim *core.Simulation) core.SimpleHealTemplate {
+	effect := druid.newHealEffect()
+	effect.HotInput = druid.newHotInput(core.HotInput{
+		NumberOfTicks:        4,
+		TickLength:           time.Second * 3,
+		TickBaseHealing:      1060.0 / 4,
+		TickSpellCoefficient: 0.8 / 4,
+	})
+	effect.HealingMultiplier *= 1 + 0.05*float64(druid.Talents.ImprovedRejuvenation)
+
+	return core.NewSimpleHealTemplate(core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: core.Cast{
+				ActionID:       core.ActionID{SpellID: SpellIDRejuvenation},
+				Character:      &druid.Character,
+				SpellSchool:    stats.NatureSpellPower,
+				BaseManaCost:   415,
+				ManaCost:       415,
+				GCD:            core.GCDDefault,
+				CritMultiplier: core.HealCritMultiplier,
+			},
+		},
+		Effect: effect,
+	})
+}
+
+func (druid *Druid) NewRejuvenation(sim *core.Simulation, target *core.Character) *core.SimpleHeal {
+	// Initialize cast from precomputed template.
+	rejuv := druid.RejuvenationOn(target)
+	druid.rejuvenationCastTemplate.Apply(rejuv)
+
+	// Set dynamic fields, i.e. the stuff we couldn't precompute.
+	rejuv.Effect.Target = target
+	rejuv.Effect.BonusHealingPower += druid.treeOfLifeBonus(target)
+	rejuv.Init(sim)
+
+	return rejuv
+}
+
+func (druid *Druid) RejuvenationOn(target *core.Character) *core.SimpleHeal {
+	return &druid.RejuvenationHeals[target.RaidIndex]
+}
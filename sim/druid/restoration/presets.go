@@ -0,0 +1,79 @@
+package restoration
+
+import (
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+var StandardTalents = &proto.DruidTalents{
+	ImprovedMarkOfTheWild: 5,
+	Naturalist:            5,
+	Intensity:             3,
+	Subtlety:              5,
+	ImprovedRejuvenation:  3,
+	NaturesSwiftness:      true,
+	GiftOfNature:          5,
+	ImprovedRegrowth:      5,
+	LivingSpirit:          3,
+	EmpoweredRejuvenation: 5,
+	TreeOfLife:            true,
+}
+
+var PlayerOptionsStandard = &proto.Player_RestorationDruid{
+	RestorationDruid: &proto.RestorationDruid{
+		Talents: StandardTalents,
+		Options: &proto.RestorationDruid_Options{
+			InnervateTarget: &proto.RaidTarget{TargetIndex: 0}, // self innervate
+		},
+		Rotation: &proto.RestorationDruid_Rotation{
+			HealThreshold:          0.05,
+			LifebloomTarget:        &proto.RaidTarget{TargetIndex: 0},
+			LifebloomRefreshWindow: 1,
+			Rejuvenation:           true,
+			RegrowthThreshold:      0.3,
+		},
+	},
+}
+
+var FullRaidBuffs = &proto.RaidBuffs{
+	ArcaneBrilliance: true,
+	GiftOfTheWild:    proto.TristateEffect_TristateEffectImproved,
+}
+var FullIndividualBuffs = &proto.IndividualBuffs{
+	BlessingOfKings:  true,
+	BlessingOfWisdom: proto.TristateEffect_TristateEffectImproved,
+}
+
+var FullConsumes = &proto.Consumes{
+	Food:            proto.Food_FoodBlackenedBasilisk,
+	DefaultPotion:   proto.Potions_SuperManaPotion,
+	DefaultConjured: proto.Conjured_ConjuredDarkRune,
+}
+
+var P1Gear = items.EquipmentSpecFromStrings([]items.ItemStringSpec{
+	items.ItemStringSpec{
+		Name: "Crown of Malorne",
+		Gems: []string{
+			"Teardrop Living Ruby",
+			"Bracing Earthstorm Diamond",
+		},
+	},
+	items.ItemStringSpec{
+		Name: "Chestguard of Malorne",
+	},
+	items.ItemStringSpec{
+		Name: "Handguards of Malorne",
+	},
+	items.ItemStringSpec{
+		Name: "Legguards of Malorne",
+	},
+	items.ItemStringSpec{
+		Name: "Light's Justice",
+	},
+	items.ItemStringSpec{
+		Name: "Lower City Prayerbook",
+	},
+	items.ItemStringSpec{
+		Name: "Essence of the Martyr",
+	},
+})
@@ -0,0 +1,159 @@
+package restoration
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/druid"
+)
+
+func RegisterRestorationDruid() {
+	core.RegisterAgentFactory(
+		proto.Player_RestorationDruid{},
+		func(character core.Character, options proto.Player) core.Agent {
+			return NewRestorationDruid(character, options)
+		},
+		func(player *proto.Player, spec interface{}) {
+			playerSpec, ok := spec.(*proto.Player_RestorationDruid)
+			if !ok {
+				panic("Invalid spec value for Restoration Druid!")
+			}
+			player.Spec = playerSpec
+		},
+	)
+}
+
+// How long to wait before checking again, when nobody needs healing.
+const idleWaitDuration = time.Millisecond * 500
+
+func NewRestorationDruid(character core.Character, options proto.Player) *RestorationDruid {
+	restoOptions := options.GetRestorationDruid()
+	character.RejectAPLRotation("Restoration Druid")
+
+	selfBuffs := druid.SelfBuffs{}
+	if restoOptions.Options.InnervateTarget != nil {
+		selfBuffs.InnervateTarget = *restoOptions.Options.InnervateTarget
+	} else {
+		selfBuffs.InnervateTarget.TargetIndex = -1
+	}
+
+	lifebloomTarget := proto.RaidTarget{TargetIndex: -1}
+	if restoOptions.Rotation.LifebloomTarget != nil {
+		lifebloomTarget = *restoOptions.Rotation.LifebloomTarget
+	}
+
+	return &RestorationDruid{
+		Druid:                  druid.New(character, selfBuffs, *restoOptions.Talents),
+		rotation:               *restoOptions.Rotation,
+		lifebloomRaidTarget:    lifebloomTarget,
+		lifebloomRefreshWindow: core.DurationFromSeconds(restoOptions.Rotation.LifebloomRefreshWindow),
+	}
+}
+
+type RestorationDruid struct {
+	*druid.Druid
+
+	rotation proto.RestorationDruid_Rotation
+
+	lifebloomRaidTarget    proto.RaidTarget
+	lifebloomTarget        *core.Character
+	lifebloomRefreshWindow time.Duration
+}
+
+// GetDruid is to implement druid.Agent
+func (resto *RestorationDruid) GetDruid() *druid.Druid {
+	return resto.Druid
+}
+
+func (resto *RestorationDruid) Init(sim *core.Simulation) {
+	resto.Druid.Init(sim)
+
+	if lifebloomTargetAgent := sim.Raid.GetPlayerFromRaidTarget(resto.lifebloomRaidTarget); lifebloomTargetAgent != nil {
+		resto.lifebloomTarget = lifebloomTargetAgent.GetCharacter()
+	}
+}
+
+func (resto *RestorationDruid) Reset(sim *core.Simulation) {
+	resto.Druid.Reset(sim)
+}
+
+func (resto *RestorationDruid) OnGCDReady(sim *core.Simulation) {
+	resto.tryUseGCD(sim)
+}
+
+func (resto *RestorationDruid) OnManaTick(sim *core.Simulation) {
+	if resto.FinishedWaitingForManaAndGCDReady(sim) {
+		resto.tryUseGCD(sim)
+	}
+}
+
+func (resto *RestorationDruid) tryUseGCD(sim *core.Simulation) {
+	if resto.lifebloomTarget != nil && resto.shouldRollLifebloom(sim) {
+		// Refreshing a Lifebloom cancels the old one, so make sure the cast won't fail.
+		if resto.CurrentMana() < resto.LifebloomManaCost() {
+			resto.WaitForMana(sim, resto.LifebloomManaCost())
+			return
+		}
+		resto.castHeal(sim, resto.NewLifebloom(sim, resto.lifebloomTarget))
+		return
+	}
+
+	// Heal the most injured player who doesn't already have our hots.
+	characters := sim.Raid.AllCharacters()
+	var healed []*core.Character
+	for {
+		target := core.MostInjuredCharacter(characters, healed)
+		if target == nil || target.MissingHealth() < target.MaxHealth()*resto.rotation.HealThreshold {
+			break
+		}
+
+		if heal := resto.chooseHeal(sim, target); heal != nil {
+			resto.castHeal(sim, heal)
+			return
+		}
+		healed = append(healed, target)
+	}
+
+	resto.WaitUntil(sim, sim.CurrentTime+idleWaitDuration)
+}
+
+// Whether the Lifebloom target needs another stack, or needs its stack
+// refreshed before it blooms.
+func (resto *RestorationDruid) shouldRollLifebloom(sim *core.Simulation) bool {
+	stacks := resto.LifebloomStacks(sim, resto.lifebloomTarget)
+	if stacks < 3 {
+		return true
+	}
+
+	if resto.lifebloomRefreshWindow < 0 {
+		return false
+	}
+	timeRemaining := resto.LifebloomOn(resto.lifebloomTarget).Effect.HotInput.TimeRemaining(sim)
+	return timeRemaining <= resto.lifebloomRefreshWindow
+}
+
+// Returns the heal to use on the target, or nil if our hots are already on them.
+func (resto *RestorationDruid) chooseHeal(sim *core.Simulation, target *core.Character) *core.SimpleHeal {
+	if resto.rotation.RegrowthThreshold > 0 &&
+		target.MissingHealth() >= target.MaxHealth()*resto.rotation.RegrowthThreshold &&
+		!resto.RegrowthOn(target).Effect.HotInput.IsTicking(sim) {
+		return resto.NewRegrowth(sim, target)
+	}
+
+	if resto.rotation.Rejuvenation && !resto.RejuvenationOn(target).Effect.HotInput.IsTicking(sim) {
+		return resto.NewRejuvenation(sim, target)
+	}
+
+	if resto.LifebloomStacks(sim, target) == 0 {
+		return resto.NewLifebloom(sim, target)
+	}
+
+	return nil
+}
+
+func (resto *RestorationDruid) castHeal(sim *core.Simulation, heal *core.SimpleHeal) {
+	if success := heal.Cast(sim); !success {
+		resto.WaitForMana(sim, heal.GetManaCost())
+	}
+}
@@ -0,0 +1,39 @@
+package restoration
+
+import (
+	"testing"
+
+	_ "github.com/wowsims/tbc/sim/common"
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func init() {
+	RegisterRestorationDruid()
+}
+
+func TestRestoration(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator(core.CharacterSuiteConfig{
+		Class: proto.Class_ClassDruid,
+
+		Race: proto.Race_RaceTauren,
+
+		GearSet: core.GearSetCombo{Label: "P1", GearSet: P1Gear},
+
+		SpecOptions: core.SpecOptionsCombo{Label: "Standard", SpecOptions: PlayerOptionsStandard},
+
+		RaidBuffs:   FullRaidBuffs,
+		PlayerBuffs: FullIndividualBuffs,
+		Consumes:    FullConsumes,
+		Debuffs:     &proto.Debuffs{},
+
+		DamageIntake: core.DefaultDamageIntake,
+
+		ItemFilter: core.ItemFilter{
+			ArmorType: proto.ArmorType_ArmorTypeLeather,
+			RangedWeaponTypes: []proto.RangedWeaponType{
+				proto.RangedWeaponType_RangedWeaponTypeIdol,
+			},
+		},
+	}))
+}
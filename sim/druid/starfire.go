@@ -72,8 +72,9 @@ func (druid *Druid) newStarfireTemplate(sim *core.Simulation, rank int) core.Sim
 	}
 
 	return core.NewSimpleSpellTemplate(core.SimpleSpell{
-		SpellCast: *spCast,
-		Effect:    effect,
+		SpellCast:    *spCast,
+		Effect:       effect,
+		MissileSpeed: 40,
 	})
 }
 
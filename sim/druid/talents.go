@@ -9,6 +9,7 @@ import (
 
 func (druid *Druid) applyTalents() {
 	druid.registerNaturesSwiftnessCD()
+	druid.applyTreeOfLife()
 
 	druid.AddStat(stats.SpellHit, float64(druid.Talents.BalanceOfPower)*2*core.SpellHitRatingPerHitChance)
 
@@ -54,8 +54,9 @@ func (druid *Druid) newWrathTemplate(sim *core.Simulation) core.SimpleSpellTempl
 	}
 
 	return core.NewSimpleSpellTemplate(core.SimpleSpell{
-		SpellCast: *spCast,
-		Effect:    effect,
+		SpellCast:    *spCast,
+		Effect:       effect,
+		MissileSpeed: 20,
 	})
 }
 
@@ -0,0 +1,60 @@
+// Prints all items, gems, and item sets with special effects that the sim does
+// not implement.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/wowsims/tbc/sim"
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/items"
+)
+
+func init() {
+	sim.RegisterAll()
+}
+
+func main() {
+	var itemDatabase = flag.String("itemDatabase", "", "Path to an item database file (.json or binary proto) to check instead of the built-in item data.")
+	var minPhase = flag.Int("minPhase", 0, "Only report items and gems from this phase or later.")
+	flag.Parse()
+
+	if *itemDatabase != "" {
+		if err := items.LoadDatabaseFile(*itemDatabase); err != nil {
+			log.Fatalf("Failed to load item database: %s", err)
+		}
+	}
+
+	var itemLines, gemLines, setLines []string
+	for _, effect := range core.GetUnimplementedEffects(items.DefaultDatabase) {
+		if effect.ItemId != 0 {
+			item := items.ByID[effect.ItemId]
+			if int(item.Phase) < *minPhase {
+				continue
+			}
+			itemLines = append(itemLines, fmt.Sprintf("%d\t%s (phase %d)", item.ID, item.Name, item.Phase))
+		} else if effect.GemId != 0 {
+			gem := items.GemsByID[effect.GemId]
+			if int(gem.Phase) < *minPhase {
+				continue
+			}
+			gemLines = append(gemLines, fmt.Sprintf("%d\t%s (phase %d)", gem.ID, gem.Name, gem.Phase))
+		} else {
+			setLines = append(setLines, effect.SetName)
+		}
+	}
+
+	printSection("Items", itemLines)
+	printSection("Meta gems", gemLines)
+	printSection("Item sets", setLines)
+}
+
+func printSection(title string, lines []string) {
+	fmt.Printf("%s with unimplemented effects: %d\n", title, len(lines))
+	for _, line := range lines {
+		fmt.Printf("\t%s\n", line)
+	}
+	fmt.Printf("\n")
+}
@@ -23,6 +23,7 @@ func (hunter *Hunter) newAimedShotTemplate(sim *core.Simulation) core.MeleeAbili
 				Value: 370,
 			},
 			CritMultiplier: hunter.critMultiplier(true, sim.GetPrimaryTarget()),
+			MissileSpeed:   ShotMissileSpeed,
 		},
 		Effect: core.AbilityHitEffect{
 			AbilityEffect: core.AbilityEffect{
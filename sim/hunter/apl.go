@@ -0,0 +1,65 @@
+package hunter
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// Registers the actions which APL rotations can use.
+func (hunter *Hunter) registerAPLActions() {
+	hunter.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{OtherID: proto.OtherAction_OtherActionShoot},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			if hunter.AutoAttacks.RangedSwingAt > sim.CurrentTime {
+				return false
+			}
+			hunter.AutoAttacks.SwingRanged(sim, target)
+			return true
+		},
+	})
+	hunter.RegisterAPLAction(core.APLActionConfig{
+		ActionID: SteadyShotActionID,
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			ss := hunter.NewSteadyShot(sim, target)
+			if success := ss.StartCast(sim); !success {
+				return false
+			}
+			// Can't use kill command while casting steady shot.
+			hunter.killCommandBlocked = true
+			return true
+		},
+	})
+	hunter.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   MultiShotActionID,
+		CooldownID: MultiShotCooldownID,
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			ms := hunter.NewMultiShot(sim)
+			return ms.StartCast(sim)
+		},
+	})
+	hunter.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   ArcaneShotActionID,
+		CooldownID: ArcaneShotCooldownID,
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return hunter.NewArcaneShot(sim, target).Attack(sim)
+		},
+	})
+	hunter.RegisterAPLAction(core.APLActionConfig{
+		ActionID: SerpentStingActionID,
+		DotRemaining: func(sim *core.Simulation, target *core.Target) time.Duration {
+			if hunter.serpentStingDot.Effect.Target != target {
+				return 0
+			}
+			return hunter.serpentStingDot.Effect.DotInput.TimeRemaining(sim)
+		},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			// The DoT object is reused, so it can't be reapplied until the old one is done.
+			if hunter.serpentStingDot.IsInUse() {
+				return false
+			}
+			return hunter.NewSerpentSting(sim, target).Attack(sim)
+		},
+	})
+}
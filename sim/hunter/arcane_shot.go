@@ -23,6 +23,7 @@ func (hunter *Hunter) newArcaneShotTemplate(sim *core.Simulation) core.MeleeAbil
 				Value: 230,
 			},
 			CritMultiplier: hunter.critMultiplier(true, sim.GetPrimaryTarget()),
+			MissileSpeed:   ShotMissileSpeed,
 		},
 		Effect: core.AbilityHitEffect{
 			AbilityEffect: core.AbilityEffect{
@@ -10,6 +10,9 @@ import (
 
 const ThoridalTheStarsFuryItemID = 34334
 
+// Speed of arrows and bullets, in yards per second.
+const ShotMissileSpeed = 40
+
 func RegisterHunter() {
 	core.RegisterAgentFactory(
 		proto.Player_Hunter{},
@@ -137,6 +140,12 @@ func (hunter *Hunter) Init(sim *core.Simulation) {
 	hunter.AutoAttacks.OH.CritMultiplier = hunter.critMultiplier(false, sim.GetPrimaryTarget())
 	hunter.AutoAttacks.Ranged.CritMultiplier = hunter.critMultiplier(true, sim.GetPrimaryTarget())
 
+	// Shot timings already include latency, so take it out of the GCD to avoid
+	// counting it twice.
+	hunter.latency = hunter.Latency.ActionLatency
+	hunter.Latency.ActionLatency = 0
+	hunter.timeToWeave = time.Millisecond*time.Duration(hunter.Rotation.TimeToWeaveMs) + hunter.latency
+
 	// Precompute all the spell templates.
 	hunter.aimedShotTemplate = hunter.newAimedShotTemplate(sim)
 	hunter.arcaneShotTemplate = hunter.newArcaneShotTemplate(sim)
@@ -193,12 +202,13 @@ func NewHunter(character core.Character, options proto.Player) *Hunter {
 		Options:   *hunterOptions.Options,
 		Rotation:  *hunterOptions.Rotation,
 
-		latency:     time.Millisecond * time.Duration(hunterOptions.Options.LatencyMs),
-		timeToWeave: time.Millisecond * time.Duration(hunterOptions.Rotation.TimeToWeaveMs+hunterOptions.Options.LatencyMs),
-
 		hasGronnstalker2Pc: ItemSetGronnstalker.CharacterHasSetBonus(&character, 2),
 	}
 
+	if hunter.Latency.ActionLatency == 0 {
+		hunter.Latency.ActionLatency = time.Millisecond * time.Duration(hunterOptions.Options.LatencyMs)
+	}
+
 	if hunter.Rotation.PercentWeaved <= 0 {
 		hunter.Rotation.Weave = proto.Hunter_Rotation_WeaveNone
 	}
@@ -220,6 +230,7 @@ func NewHunter(character core.Character, options proto.Player) *Hunter {
 			return hunter.TryRaptorStrike(sim)
 		},
 	})
+	hunter.AutoAttacks.RangedAuto.MissileSpeed = ShotMissileSpeed
 	if hunter.Options.RemoveRandomness {
 		weaponAvg := (hunter.AutoAttacks.Ranged.BaseDamageMin + hunter.AutoAttacks.Ranged.BaseDamageMax) / 2
 		hunter.AutoAttacks.Ranged.BaseDamageMin = weaponAvg
@@ -230,6 +241,7 @@ func NewHunter(character core.Character, options proto.Player) *Hunter {
 	}
 
 	hunter.pet = hunter.NewHunterPet()
+	hunter.registerAPLActions()
 
 	hunter.AddStatDependency(stats.StatDependency{
 		SourceStat:   stats.Intellect,
@@ -23,7 +23,7 @@ func (hunter *Hunter) applyKillCommand() {
 			OnMeleeAttack: func(sim *core.Simulation, ability *core.ActiveMeleeAbility, hitEffect *core.AbilityHitEffect) {
 				if hitEffect.HitType == core.MeleeHitTypeCrit {
 					hunter.killCommandEnabledUntil = sim.CurrentTime + time.Second*5
-					hunter.TryKillCommand(sim, sim.GetPrimaryTarget())
+					hunter.TryKillCommand(sim, hunter.CurrentTarget(sim))
 				}
 			},
 		}
@@ -51,6 +51,7 @@ func (hunter *Hunter) newMultiShotAbilityTemplate(sim *core.Simulation) core.Mel
 			// TODO: If we ever allow multiple targets to have their own type, need to
 			// update this.
 			CritMultiplier: hunter.critMultiplier(true, sim.GetPrimaryTarget()),
+			MissileSpeed:   ShotMissileSpeed,
 		},
 	}
 
@@ -55,7 +55,7 @@ func (hunter *Hunter) NewHunterPet() *HunterPet {
 	hp.damageMultiplier *= 1.25
 
 	hp.EnableFocusBar(1.0+0.5*float64(hunter.Talents.BestialDiscipline), func(sim *core.Simulation) {
-		if !hp.IsOnCD(core.GCDCooldownID, sim.CurrentTime) {
+		if !hp.IsOnCD(core.GCDCooldownID, sim.CurrentTime) && !hp.IsMoving(sim) {
 			hp.OnGCDReady(sim)
 		}
 	})
@@ -127,6 +127,8 @@ func (hp *HunterPet) Reset(sim *core.Simulation) {
 	hp.deathTime = time.Duration(float64(sim.Duration) * uptime)
 }
 
+// Pets move with their owner. Hunter pets don't implement core.MovingAgent,
+// since their abilities need melee range, so they wait for the movement to end.
 func (hp *HunterPet) OnGCDReady(sim *core.Simulation) {
 	if sim.CurrentTime > hp.deathTime {
 		hp.Disable(sim)
@@ -134,7 +136,7 @@ func (hp *HunterPet) OnGCDReady(sim *core.Simulation) {
 		return
 	}
 
-	target := sim.GetPrimaryTarget()
+	target := hp.CurrentTarget(sim)
 	if hp.config.RandomSelection {
 		if sim.RandomFloat("Hunter Pet Ability") < 0.5 {
 			if !hp.primaryAbility.TryCast(sim, target, hp) {
@@ -61,5 +61,5 @@ func (hunter *Hunter) TryRaptorStrike(sim *core.Simulation) *core.ActiveMeleeAbi
 		return nil
 	}
 
-	return hunter.NewRaptorStrike(sim, sim.GetPrimaryTarget())
+	return hunter.NewRaptorStrike(sim, hunter.CurrentTarget(sim))
 }
@@ -31,14 +31,14 @@ func (hunter *Hunter) OnManaTick(sim *core.Simulation) {
 		hunter.AddMana(sim, manaGain, AspectOfTheViperActionID, false)
 	}
 
-	if hunter.IsWaitingForMana() && hunter.DoneWaitingForMana(sim) {
-		hunter.TryKillCommand(sim, sim.GetPrimaryTarget())
+	if hunter.IsWaitingForMana() && !hunter.IsMoving(sim) && hunter.DoneWaitingForMana(sim) {
+		hunter.TryKillCommand(sim, hunter.CurrentTarget(sim))
 		hunter.rotation(sim, false)
 	}
 }
 
 func (hunter *Hunter) OnAutoAttack(sim *core.Simulation, ability *core.ActiveMeleeAbility) {
-	hunter.TryKillCommand(sim, sim.GetPrimaryTarget())
+	hunter.TryKillCommand(sim, hunter.CurrentTarget(sim))
 	if !ability.Effect.IsRanged() {
 		return
 	}
@@ -48,9 +48,9 @@ func (hunter *Hunter) OnAutoAttack(sim *core.Simulation, ability *core.ActiveMel
 func (hunter *Hunter) OnGCDReady(sim *core.Simulation) {
 	if sim.CurrentTime == 0 {
 		if hunter.Rotation.PrecastAimedShot && hunter.Talents.AimedShot {
-			hunter.NewAimedShot(sim, sim.GetPrimaryTarget()).Attack(sim)
+			hunter.NewAimedShot(sim, hunter.CurrentTarget(sim)).Attack(sim)
 		}
-		hunter.AutoAttacks.SwingRanged(sim, sim.GetPrimaryTarget())
+		hunter.AutoAttacks.SwingRanged(sim, hunter.CurrentTarget(sim))
 		return
 	}
 
@@ -58,12 +58,41 @@ func (hunter *Hunter) OnGCDReady(sim *core.Simulation) {
 		return
 	}
 
-	hunter.TryKillCommand(sim, sim.GetPrimaryTarget())
+	hunter.TryKillCommand(sim, hunter.CurrentTarget(sim))
 
 	hunter.rotation(sim, false)
 }
 
+// Shots with a cast time and auto shots can't be used while moving, but
+// Arcane Shot can. Any planned action is dropped, so the rotation starts fresh
+// once the movement ends.
+func (hunter *Hunter) OnGCDReadyWhileMoving(sim *core.Simulation) {
+	hunter.nextAction = OptionNone
+	hunter.nextActionAt = 0
+	hunter.killCommandBlocked = false
+
+	hunter.TryKillCommand(sim, hunter.CurrentTarget(sim))
+
+	if hunter.Rotation.UseArcaneShot && !hunter.IsOnCD(ArcaneShotCooldownID, sim.CurrentTime) {
+		as := hunter.NewArcaneShot(sim, hunter.CurrentTarget(sim))
+		if success := as.Attack(sim); success {
+			return
+		}
+	}
+
+	hunter.WaitUntil(sim, hunter.MovementEndsAt())
+}
+
 func (hunter *Hunter) rotation(sim *core.Simulation, followsRangedAuto bool) {
+	if hunter.HasAPLRotation() {
+		// Ranged autos and mana ticks also call this, so only use the APL once
+		// the GCD is actually ready.
+		if hunter.NextGCDAt() <= sim.CurrentTime && hunter.Hardcast.Expires <= sim.CurrentTime {
+			hunter.DoAPLRotation(sim)
+		}
+		return
+	}
+
 	if hunter.nextAction == OptionNone {
 		if hunter.Rotation.LazyRotation {
 			hunter.lazyRotation(sim, followsRangedAuto)
@@ -276,7 +305,7 @@ func (hunter *Hunter) adaptiveRotation(sim *core.Simulation, followsRangedAuto b
 
 func (hunter *Hunter) doOption(sim *core.Simulation, option int) {
 	hunter.nextAction = OptionNone
-	target := sim.GetPrimaryTarget()
+	target := hunter.CurrentTarget(sim)
 	switch option {
 	case OptionShoot:
 		hunter.AutoAttacks.SwingRanged(sim, target)
@@ -342,7 +371,7 @@ func (hunter *Hunter) tryUsePrioGCD(sim *core.Simulation) bool {
 		}
 	}
 
-	target := sim.GetPrimaryTarget()
+	target := hunter.CurrentTarget(sim)
 
 	if hunter.Rotation.Sting == proto.Hunter_Rotation_ScorpidSting && !target.HasAura(ScorpidStingDebuffID) {
 		ss := hunter.NewScorpidSting(sim, target)
@@ -368,13 +397,13 @@ func (hunter *Hunter) doMeleeWeave(sim *core.Simulation) {
 		hunter.SetGCDTimer(sim, doneWeavingAt)
 	}
 
-	hunter.AutoAttacks.TrySwingMH(sim, sim.GetPrimaryTarget())
+	hunter.AutoAttacks.TrySwingMH(sim, hunter.CurrentTarget(sim))
 	hunter.HardcastWaitUntil(sim, doneWeavingAt, &hunter.fakeHardcast)
 }
 
 func (hunter *Hunter) GetPresimOptions() *core.PresimOptions {
 	// If not adaptive, don't need to run a presim.
-	if hunter.Rotation.LazyRotation {
+	if hunter.Rotation.LazyRotation || hunter.HasAPLRotation() {
 		return nil
 	}
 
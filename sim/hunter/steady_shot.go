@@ -22,7 +22,7 @@ func (hunter *Hunter) newSteadyShotCastTemplate(sim *core.Simulation) core.Simpl
 			GCD:         core.GCDDefault,
 			IgnoreHaste: true, // Hunter GCD is locked at 1.5s
 			OnCastComplete: func(sim *core.Simulation, cast *core.Cast) {
-				target := sim.GetPrimaryTarget()
+				target := hunter.CurrentTarget(sim)
 				ss := &hunter.steadyShotAbility
 				hunter.steadyShotAbilityTemplate.Apply(ss)
 				ss.Effect.Target = target
@@ -50,6 +50,7 @@ func (hunter *Hunter) newSteadyShotAbilityTemplate(sim *core.Simulation) core.Me
 			SpellSchool:    stats.AttackPower,
 			IgnoreCost:     true,
 			CritMultiplier: hunter.critMultiplier(true, sim.GetPrimaryTarget()),
+			MissileSpeed:   ShotMissileSpeed,
 		},
 		Effect: core.AbilityHitEffect{
 			AbilityEffect: core.AbilityEffect{
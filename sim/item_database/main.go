@@ -0,0 +1,25 @@
+// Writes the item database compiled into the sim to a versioned file, which
+// can be loaded at startup instead of the built-in data (see -itemDatabase in
+// sim/web, or loadItemDatabase in the wasm module).
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/wowsims/tbc/sim/core/items"
+)
+
+func main() {
+	var outFile = flag.String("outFile", "", "Path to write the item database to. Files ending in .json are written as JSON, anything else as binary proto.")
+	flag.Parse()
+
+	if *outFile == "" {
+		log.Fatal("outFile flag is required!")
+	}
+
+	if err := items.DefaultDatabase.WriteFile(*outFile); err != nil {
+		log.Fatalf("Failed to write item database: %s", err)
+	}
+	log.Printf("Wrote %d items, %d gems and %d enchants to %s", len(items.Items), len(items.Gems), len(items.Enchants), *outFile)
+}
@@ -0,0 +1,67 @@
+package mage
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+)
+
+// Registers the actions which APL rotations can use.
+func (mage *Mage) registerAPLActions() {
+	mage.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDArcaneBlast},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			arcaneBlast, _ := mage.NewArcaneBlast(sim, target)
+			return arcaneBlast.Cast(sim)
+		},
+	})
+	mage.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDArcaneMissiles},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return mage.NewArcaneMissiles(sim, target).Cast(sim)
+		},
+	})
+	mage.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDFrostbolt},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return mage.NewFrostbolt(sim, target).Cast(sim)
+		},
+	})
+	mage.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDFireball},
+		DotRemaining: func(sim *core.Simulation, target *core.Target) time.Duration {
+			if mage.fireballDotSpell.Effect.Target != target {
+				return 0
+			}
+			return mage.fireballDotSpell.Effect.DotInput.TimeRemaining(sim)
+		},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return mage.NewFireball(sim, target).Cast(sim)
+		},
+	})
+	mage.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDScorch},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return mage.NewScorch(sim, target).Cast(sim)
+		},
+	})
+	mage.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   core.ActionID{SpellID: SpellIDFireBlast},
+		CooldownID: FireBlastCooldownID,
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return mage.NewFireBlast(sim, target).Cast(sim)
+		},
+	})
+	mage.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDPyroblast},
+		DotRemaining: func(sim *core.Simulation, target *core.Target) time.Duration {
+			if mage.pyroblastDotSpell.Effect.Target != target {
+				return 0
+			}
+			return mage.pyroblastDotSpell.Effect.DotInput.TimeRemaining(sim)
+		},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return mage.NewPyroblast(sim, target).Cast(sim)
+		},
+	})
+}
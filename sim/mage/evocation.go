@@ -47,6 +47,7 @@ func (mage *Mage) registerEvocationCD() {
 		CooldownID: EvocationCooldownID,
 		Cooldown:   cooldown,
 		UsesGCD:    true,
+		CastTime:   castTime,
 		Type:       core.CooldownTypeMana,
 		CanActivate: func(sim *core.Simulation, character *core.Character) bool {
 			return true
@@ -43,6 +43,7 @@ func (mage *Mage) newFireballTemplate(sim *core.Simulation) core.SimpleSpellTemp
 				SpellCoefficient: 1.0,
 			},
 		},
+		MissileSpeed: 24,
 	}
 
 	spell.CastTime -= time.Millisecond * 100 * time.Duration(mage.Talents.ImprovedFireball)
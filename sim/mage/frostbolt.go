@@ -36,6 +36,7 @@ func (mage *Mage) newFrostboltTemplate(sim *core.Simulation) core.SimpleSpellTem
 				SpellCoefficient: (3.0 / 3.5) * 0.95,
 			},
 		},
+		MissileSpeed: 28,
 	}
 
 	spell.CastTime -= time.Millisecond * 100 * time.Duration(mage.Talents.ImprovedFrostbolt)
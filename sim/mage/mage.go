@@ -195,6 +195,7 @@ func NewMage(character core.Character, options proto.Player) *Mage {
 
 	mage.registerEvocationCD()
 	mage.registerManaGemsCD()
+	mage.registerAPLActions()
 	mage.applyTalents()
 
 	mage.hasTristfal = ItemSetTirisfalRegalia.CharacterHasSetBonus(&mage.Character, 2)
@@ -43,6 +43,7 @@ func (mage *Mage) newPyroblastTemplate(sim *core.Simulation) core.SimpleSpellTem
 				SpellCoefficient: 1.15,
 			},
 		},
+		MissileSpeed: 24,
 	}
 
 	spell.ManaCost -= spell.BaseManaCost * float64(mage.Talents.Pyromaniac) * 0.01
@@ -18,6 +18,11 @@ func (mage *Mage) OnManaTick(sim *core.Simulation) {
 }
 
 func (mage *Mage) tryUseGCD(sim *core.Simulation) {
+	if mage.HasAPLRotation() {
+		mage.DoAPLRotation(sim)
+		return
+	}
+
 	var spell *core.SimpleSpell
 	if mage.RotationType == proto.Mage_Rotation_Arcane {
 		spell = mage.doArcaneRotation(sim)
@@ -37,8 +42,8 @@ func (mage *Mage) tryUseGCD(sim *core.Simulation) {
 
 	numStacks := mage.NumStacks(ArcaneBlastAuraID)
 	if numStacks > 0 && sim.GetRemainingDuration() > time.Second*5 {
-		// Wait for AB stacks to drop.
-		waitTime := mage.RemainingAuraDuration(sim, ArcaneBlastAuraID) + time.Millisecond*100
+		// Wait for AB stacks to drop, and for the mage to notice that they have.
+		waitTime := mage.RemainingAuraDuration(sim, ArcaneBlastAuraID) + time.Millisecond*100 + mage.ReactionDelay(sim)
 		if sim.Log != nil {
 			mage.Log(sim, "Waiting for AB stacks to drop: %0.02f", waitTime.Seconds())
 		}
@@ -49,6 +54,21 @@ func (mage *Mage) tryUseGCD(sim *core.Simulation) {
 	}
 }
 
+// Fire Blast is the only instant with enough range to use while moving, so
+// it's used whenever it's ready and the mage otherwise waits.
+func (mage *Mage) OnGCDReadyWhileMoving(sim *core.Simulation) {
+	if !mage.IsOnCD(FireBlastCooldownID, sim.CurrentTime) {
+		spell := mage.NewFireBlast(sim, mage.CurrentTarget(sim))
+		if success := spell.Cast(sim); success {
+			return
+		}
+		mage.WaitUntil(sim, mage.MovementEndsAt())
+		return
+	}
+
+	mage.WaitUntil(sim, core.MinDuration(mage.MovementEndsAt(), mage.CDReadyAt(FireBlastCooldownID)))
+}
+
 func (mage *Mage) doArcaneRotation(sim *core.Simulation) *core.SimpleSpell {
 	if mage.UseAoeRotation {
 		return mage.doAoeRotation(sim)
@@ -58,7 +78,7 @@ func (mage *Mage) doArcaneRotation(sim *core.Simulation) *core.SimpleSpell {
 	// Don't need to update tracker because we only use certain functions.
 	//mage.manaTracker.Update(sim, mage.GetCharacter())
 
-	target := sim.GetPrimaryTarget()
+	target := mage.CurrentTarget(sim)
 
 	// Create an AB object because we use its mana cost / cast time in many of our calculations.
 	arcaneBlast, numStacks := mage.NewArcaneBlast(sim, target)
@@ -162,7 +182,7 @@ func (mage *Mage) doArcaneRotation(sim *core.Simulation) *core.SimpleSpell {
 }
 
 func (mage *Mage) doFireRotation(sim *core.Simulation) *core.SimpleSpell {
-	target := sim.GetPrimaryTarget()
+	target := mage.CurrentTarget(sim)
 
 	if mage.FireRotation.MaintainImprovedScorch && (target.NumStacks(core.ImprovedScorchDebuffID) < 5 || target.RemainingAuraDuration(sim, core.ImprovedScorchDebuffID) < time.Millisecond*5500) {
 		return mage.NewScorch(sim, target)
@@ -188,7 +208,7 @@ func (mage *Mage) doFrostRotation(sim *core.Simulation) *core.SimpleSpell {
 		return mage.doAoeRotation(sim)
 	}
 
-	target := sim.GetPrimaryTarget()
+	target := mage.CurrentTarget(sim)
 	spell := mage.NewFrostbolt(sim, target)
 	return spell
 }
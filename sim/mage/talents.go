@@ -169,7 +169,7 @@ func (mage *Mage) registerPresenceOfMindCD() {
 		},
 		ActivationFactory: func(sim *core.Simulation) core.CooldownActivation {
 			return func(sim *core.Simulation, character *core.Character) {
-				target := sim.GetPrimaryTarget()
+				target := character.CurrentTarget(sim)
 				var spell *core.SimpleSpell
 				if mage.Talents.Pyroblast {
 					spell = mage.NewPyroblast(sim, target)
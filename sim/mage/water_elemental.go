@@ -109,13 +109,15 @@ func (we *WaterElemental) Init(sim *core.Simulation) {
 func (we *WaterElemental) Reset(newsim *core.Simulation) {
 }
 
+// Pets move with their owner. Waterbolt has a cast time, so the Water Elemental
+// doesn't implement core.MovingAgent and waits for the movement to end.
 func (we *WaterElemental) OnGCDReady(sim *core.Simulation) {
 	// There's some edge case where this causes a panic, haven't figured it out yet.
 	if we.waterboltSpell.IsInUse() {
 		we.waterboltSpell.Cancel(sim)
 	}
 
-	spell := we.NewWaterbolt(sim, sim.GetPrimaryTarget())
+	spell := we.NewWaterbolt(sim, we.CurrentTarget(sim))
 
 	if sim.RandomFloat("Water Elemental Disobey") < we.disobeyChance {
 		// Water ele has decided not to cooperate, so just wait for the cast time
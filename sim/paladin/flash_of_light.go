@@ -0,0 +1,50 @@
+package paladin
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const MaxFlashOfLightRank = 7
+
+var FlashOfLightRanks = map[int32]healRank{
+	6: {SpellID: 19943, MinBaseHealing: 348, MaxBaseHealing: 389, ManaCost: 140, SpellCoefficient: 1.5 / 3.5 * (58 + 11) / 70},
+	7: {SpellID: 27137, MinBaseHealing: 448, MaxBaseHealing: 503, ManaCost: 180, SpellCoefficient: 1.5 / 3.5},
+}
+
+func (paladin *Paladin) newFlashOfLightTemplate(rank healRank) core.SimpleHealTemplate {
+	healTemplate := core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: core.Cast{
+				ActionID:       core.ActionID{SpellID: rank.SpellID},
+				Character:      &paladin.Character,
+				SpellSchool:    stats.HolySpellPower,
+				BaseManaCost:   rank.ManaCost,
+				ManaCost:       rank.ManaCost,
+				CastTime:       time.Millisecond * 1500,
+				GCD:            core.GCDDefault,
+				CritMultiplier: core.HealCritMultiplier,
+			},
+		},
+		Effect: paladin.newHealEffect(rank),
+	}
+
+	return core.NewSimpleHealTemplate(healTemplate)
+}
+
+func (paladin *Paladin) NewFlashOfLight(sim *core.Simulation, target *core.Character, rank int32) *core.SimpleHeal {
+	// Initialize cast from precomputed template.
+	fol := &paladin.flashOfLightSpell
+	template := healTemplateForRank("Flash of Light", paladin.flashOfLightCastTemplates, rank)
+	template.Apply(fol)
+
+	// Set dynamic fields, i.e. the stuff we couldn't precompute.
+	fol.Effect.Target = target
+	paladin.applyDivineFavor(fol)
+
+	fol.Init(sim)
+
+	return fol
+}
@@ -0,0 +1,71 @@
+package paladin
+
+import (
+	"fmt"
+
+	"github.com/wowsims/tbc/sim/core"
+)
+
+// The values for 1 rank of a paladin heal.
+type healRank struct {
+	SpellID        int32
+	MinBaseHealing float64
+	MaxBaseHealing float64
+	ManaCost       float64
+
+	// Includes the penalty for ranks learned below level 59, if any.
+	SpellCoefficient float64
+}
+
+var IlluminationActionID = core.ActionID{SpellID: 20272}
+
+// Helper for precomputing heal effects, with the talents shared by all paladin heals.
+func (paladin *Paladin) newHealEffect(rank healRank) core.HealEffect {
+	effect := core.HealEffect{
+		HealingMultiplier: 1,
+		DirectInput: core.DirectHealInput{
+			MinBaseHealing:   rank.MinBaseHealing,
+			MaxBaseHealing:   rank.MaxBaseHealing,
+			SpellCoefficient: rank.SpellCoefficient,
+		},
+	}
+
+	effect.HealingMultiplier *= 1 + 0.04*float64(paladin.Talents.HealingLight)
+	effect.BonusCritRating += float64(paladin.Talents.HolyPower) * 1 * core.SpellCritRatingPerCritChance
+
+	if paladin.Talents.Illumination > 0 {
+		procChance := 0.2 * float64(paladin.Talents.Illumination)
+		effect.OnHeal = func(sim *core.Simulation, spellCast *core.SpellCast, healEffect *core.HealEffect) {
+			paladin.tryIllumination(sim, spellCast, healEffect, procChance)
+		}
+	}
+
+	return effect
+}
+
+// Illumination refunds 60% of the base mana cost when a heal crits.
+func (paladin *Paladin) tryIllumination(sim *core.Simulation, spellCast *core.SpellCast, healEffect *core.HealEffect, procChance float64) {
+	if !healEffect.Crit {
+		return
+	}
+	if procChance < 1 && sim.RandomFloat("Illumination") > procChance {
+		return
+	}
+	paladin.AddMana(sim, spellCast.BaseManaCost*0.6, IlluminationActionID, false)
+}
+
+func newHealTemplates(ranks map[int32]healRank, newTemplate func(rank healRank) core.SimpleHealTemplate) map[int32]core.SimpleHealTemplate {
+	templates := make(map[int32]core.SimpleHealTemplate, len(ranks))
+	for rankNumber, rank := range ranks {
+		templates[rankNumber] = newTemplate(rank)
+	}
+	return templates
+}
+
+func healTemplateForRank(spellName string, templates map[int32]core.SimpleHealTemplate, rank int32) core.SimpleHealTemplate {
+	template, ok := templates[rank]
+	if !ok {
+		panic(fmt.Sprintf("Unsupported %s rank: %d", spellName, rank))
+	}
+	return template
+}
@@ -0,0 +1,115 @@
+package holy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/paladin"
+)
+
+func RegisterHolyPaladin() {
+	core.RegisterAgentFactory(
+		proto.Player_HolyPaladin{},
+		func(character core.Character, options proto.Player) core.Agent {
+			return NewHolyPaladin(character, options)
+		},
+		func(player *proto.Player, spec interface{}) {
+			playerSpec, ok := spec.(*proto.Player_HolyPaladin)
+			if !ok {
+				panic("Invalid spec value for Holy Paladin!")
+			}
+			player.Spec = playerSpec
+		},
+	)
+}
+
+// How long to wait before checking again, when nobody needs healing.
+const idleWaitDuration = time.Millisecond * 500
+
+func NewHolyPaladin(character core.Character, options proto.Player) *HolyPaladin {
+	holyOptions := options.GetHolyPaladin()
+	character.RejectAPLRotation("Holy Paladin")
+
+	holy := &HolyPaladin{
+		Paladin:          paladin.NewPaladin(character, *holyOptions.Talents),
+		Rotation:         *holyOptions.Rotation,
+		holyLightRank:    holyOptions.Rotation.HolyLightRank,
+		flashOfLightRank: holyOptions.Rotation.FlashOfLightRank,
+	}
+
+	if holy.holyLightRank == 0 {
+		holy.holyLightRank = paladin.MaxHolyLightRank
+	}
+	if holy.flashOfLightRank == 0 {
+		holy.flashOfLightRank = paladin.MaxFlashOfLightRank
+	}
+
+	// Check the ranks up front, rather than failing on the first cast.
+	if _, ok := paladin.HolyLightRanks[holy.holyLightRank]; !ok {
+		panic(fmt.Sprintf("Unsupported Holy Light rank: %d", holy.holyLightRank))
+	}
+	if _, ok := paladin.FlashOfLightRanks[holy.flashOfLightRank]; !ok {
+		panic(fmt.Sprintf("Unsupported Flash of Light rank: %d", holy.flashOfLightRank))
+	}
+
+	return holy
+}
+
+type HolyPaladin struct {
+	*paladin.Paladin
+
+	Rotation proto.HolyPaladin_Rotation
+
+	holyLightRank    int32
+	flashOfLightRank int32
+}
+
+func (holy *HolyPaladin) GetPaladin() *paladin.Paladin {
+	return holy.Paladin
+}
+
+func (holy *HolyPaladin) Reset(sim *core.Simulation) {
+	holy.Paladin.Reset(sim)
+}
+
+func (holy *HolyPaladin) OnGCDReady(sim *core.Simulation) {
+	holy.tryUseGCD(sim)
+}
+
+func (holy *HolyPaladin) OnManaTick(sim *core.Simulation) {
+	if holy.FinishedWaitingForManaAndGCDReady(sim) {
+		holy.tryUseGCD(sim)
+	}
+}
+
+func (holy *HolyPaladin) tryUseGCD(sim *core.Simulation) {
+	target := core.MostInjuredCharacter(sim.Raid.AllCharacters(), nil)
+	if target == nil || target.MissingHealth() < target.MaxHealth()*holy.Rotation.HealThreshold {
+		holy.WaitUntil(sim, sim.CurrentTime+idleWaitDuration)
+		return
+	}
+
+	var heal *core.SimpleHeal
+	if holy.shouldUseHolyLight(target) {
+		heal = holy.NewHolyLight(sim, target, holy.holyLightRank)
+	} else {
+		heal = holy.NewFlashOfLight(sim, target, holy.flashOfLightRank)
+	}
+
+	if success := heal.Cast(sim); !success {
+		holy.WaitForMana(sim, heal.GetManaCost())
+	}
+}
+
+func (holy *HolyPaladin) shouldUseHolyLight(target *core.Character) bool {
+	switch holy.Rotation.PrimarySpell {
+	case proto.HolyPaladin_Rotation_HolyLight:
+		return true
+	case proto.HolyPaladin_Rotation_FlashOfLight:
+		return false
+	default:
+		return target.MissingHealth() >= target.MaxHealth()*holy.Rotation.HolyLightThreshold
+	}
+}
@@ -0,0 +1,79 @@
+package holy
+
+import (
+	"testing"
+
+	_ "github.com/wowsims/tbc/sim/common"
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func init() {
+	RegisterHolyPaladin()
+}
+
+func TestHoly(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator(core.CharacterSuiteConfig{
+		Class: proto.Class_ClassPaladin,
+
+		Race: proto.Race_RaceBloodElf,
+
+		GearSet: core.GearSetCombo{Label: "P1", GearSet: P1Gear},
+
+		SpecOptions: core.SpecOptionsCombo{Label: "Adaptive", SpecOptions: PlayerOptionsAdaptive},
+		OtherSpecOptions: []core.SpecOptionsCombo{
+			core.SpecOptionsCombo{Label: "FlashOfLight", SpecOptions: PlayerOptionsFlashOfLight},
+		},
+
+		RaidBuffs:   FullRaidBuffs,
+		PlayerBuffs: FullIndividualBuffs,
+		Consumes:    FullConsumes,
+		Debuffs:     &proto.Debuffs{},
+
+		DamageIntake: core.DefaultDamageIntake,
+
+		ItemFilter: core.ItemFilter{
+			ArmorType: proto.ArmorType_ArmorTypePlate,
+			RangedWeaponTypes: []proto.RangedWeaponType{
+				proto.RangedWeaponType_RangedWeaponTypeLibram,
+			},
+		},
+	}))
+}
+
+func TestHolyGoesOOM(t *testing.T) {
+	duration := 300.0
+	result := core.RunRaidSim(&proto.RaidSimRequest{
+		Raid: core.SinglePlayerRaidProto(&proto.Player{
+			Name:      "Holy Paladin",
+			Race:      proto.Race_RaceBloodElf,
+			Class:     proto.Class_ClassPaladin,
+			Equipment: P1Gear,
+			Consumes:  &proto.Consumes{},
+			Spec:      PlayerOptionsAdaptive,
+			Buffs:     &proto.IndividualBuffs{},
+		}, nil, &proto.RaidBuffs{}),
+		Encounter: &proto.Encounter{
+			Duration: duration,
+			Targets: []*proto.Target{
+				&proto.Target{},
+			},
+			DamageIntake: []*proto.DamageIntake{
+				&proto.DamageIntake{
+					Damage:    800,
+					StartTime: 1,
+					Interval:  1,
+				},
+			},
+		},
+		SimOptions: &proto.SimOptions{
+			Iterations: 1,
+			IsTest:     true,
+		},
+	})
+
+	playerMetrics := result.RaidMetrics.Parties[0].Players[0]
+	if playerMetrics.SecondsToOomAvg <= 0 || playerMetrics.SecondsToOomAvg >= duration {
+		t.Fatalf("Expected to run out of mana before the end of the encounter, got time to OOM %0.02f", playerMetrics.SecondsToOomAvg)
+	}
+}
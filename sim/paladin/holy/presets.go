@@ -0,0 +1,88 @@
+package holy
+
+import (
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+var StandardTalents = &proto.PaladinTalents{
+	DivineIntellect:          5,
+	HealingLight:             3,
+	Illumination:             5,
+	ImprovedBlessingOfWisdom: 2,
+	DivineFavor:              true,
+	SanctifiedLight:          3,
+	HolyPower:                5,
+	LightsGrace:              3,
+}
+
+var holyOptions = &proto.HolyPaladin_Options{}
+
+var PlayerOptionsAdaptive = &proto.Player_HolyPaladin{
+	HolyPaladin: &proto.HolyPaladin{
+		Talents: StandardTalents,
+		Options: holyOptions,
+		Rotation: &proto.HolyPaladin_Rotation{
+			PrimarySpell:       proto.HolyPaladin_Rotation_Adaptive,
+			HealThreshold:      0.05,
+			HolyLightThreshold: 0.3,
+		},
+	},
+}
+
+var PlayerOptionsFlashOfLight = &proto.Player_HolyPaladin{
+	HolyPaladin: &proto.HolyPaladin{
+		Talents: StandardTalents,
+		Options: holyOptions,
+		Rotation: &proto.HolyPaladin_Rotation{
+			PrimarySpell:  proto.HolyPaladin_Rotation_FlashOfLight,
+			HealThreshold: 0.05,
+		},
+	},
+}
+
+var FullRaidBuffs = &proto.RaidBuffs{
+	ArcaneBrilliance: true,
+	GiftOfTheWild:    proto.TristateEffect_TristateEffectImproved,
+}
+var FullIndividualBuffs = &proto.IndividualBuffs{
+	BlessingOfKings:  true,
+	BlessingOfWisdom: proto.TristateEffect_TristateEffectImproved,
+}
+
+var FullConsumes = &proto.Consumes{
+	Food:            proto.Food_FoodBlackenedBasilisk,
+	DefaultPotion:   proto.Potions_SuperManaPotion,
+	DefaultConjured: proto.Conjured_ConjuredDarkRune,
+}
+
+var P1Gear = items.EquipmentSpecFromStrings([]items.ItemStringSpec{
+	items.ItemStringSpec{
+		Name: "Justicar Diadem",
+		Gems: []string{
+			"Teardrop Living Ruby",
+			"Insightful Earthstorm Diamond",
+		},
+	},
+	items.ItemStringSpec{
+		Name: "Justicar Pauldrons",
+	},
+	items.ItemStringSpec{
+		Name: "Justicar Chestpiece",
+	},
+	items.ItemStringSpec{
+		Name: "Justicar Gloves",
+	},
+	items.ItemStringSpec{
+		Name: "Justicar Leggings",
+	},
+	items.ItemStringSpec{
+		Name: "Light's Justice",
+	},
+	items.ItemStringSpec{
+		Name: "Lower City Prayerbook",
+	},
+	items.ItemStringSpec{
+		Name: "Essence of the Martyr",
+	},
+})
@@ -0,0 +1,98 @@
+package paladin
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const MaxHolyLightRank = 11
+
+var HolyLightRanks = map[int32]healRank{
+	9:  {SpellID: 25292, MinBaseHealing: 1590, MaxBaseHealing: 1771, ManaCost: 660, SpellCoefficient: 2.5 / 3.5},
+	10: {SpellID: 27135, MinBaseHealing: 1773, MaxBaseHealing: 1974, ManaCost: 710, SpellCoefficient: 2.5 / 3.5},
+	11: {SpellID: 27136, MinBaseHealing: 2196, MaxBaseHealing: 2446, ManaCost: 840, SpellCoefficient: 2.5 / 3.5},
+}
+
+func IsHolyLight(spellID int32) bool {
+	for _, rank := range HolyLightRanks {
+		if rank.SpellID == spellID {
+			return true
+		}
+	}
+	return false
+}
+
+func (paladin *Paladin) newHolyLightTemplate(rank healRank) core.SimpleHealTemplate {
+	healTemplate := core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: core.Cast{
+				ActionID:       core.ActionID{SpellID: rank.SpellID},
+				Character:      &paladin.Character,
+				SpellSchool:    stats.HolySpellPower,
+				BaseManaCost:   rank.ManaCost,
+				ManaCost:       rank.ManaCost,
+				CastTime:       time.Millisecond * 2500,
+				GCD:            core.GCDDefault,
+				CritMultiplier: core.HealCritMultiplier,
+			},
+		},
+	}
+
+	effect := paladin.newHealEffect(rank)
+	effect.BonusCritRating += float64(paladin.Talents.SanctifiedLight) * 2 * core.SpellCritRatingPerCritChance
+
+	if paladin.Talents.LightsGrace > 0 {
+		procChance := float64(paladin.Talents.LightsGrace) / 3
+
+		onHeal := effect.OnHeal
+		effect.OnHeal = func(sim *core.Simulation, spellCast *core.SpellCast, healEffect *core.HealEffect) {
+			if onHeal != nil {
+				onHeal(sim, spellCast, healEffect)
+			}
+			if procChance == 1 || sim.RandomFloat("Light's Grace") < procChance {
+				paladin.ReplaceAura(sim, paladin.lightsGraceAura(sim))
+			}
+		}
+	}
+
+	healTemplate.Effect = effect
+	return core.NewSimpleHealTemplate(healTemplate)
+}
+
+func (paladin *Paladin) NewHolyLight(sim *core.Simulation, target *core.Character, rank int32) *core.SimpleHeal {
+	// Initialize cast from precomputed template.
+	hl := &paladin.holyLightSpell
+	template := healTemplateForRank("Holy Light", paladin.holyLightCastTemplates, rank)
+	template.Apply(hl)
+
+	// Set dynamic fields, i.e. the stuff we couldn't precompute.
+	hl.Effect.Target = target
+	paladin.applyDivineFavor(hl)
+
+	hl.Init(sim)
+
+	return hl
+}
+
+var LightsGraceAuraID = core.NewAuraID()
+
+// Light's Grace reduces the cast time of the next Holy Light.
+func (paladin *Paladin) lightsGraceAura(sim *core.Simulation) core.Aura {
+	return core.Aura{
+		ID:       LightsGraceAuraID,
+		ActionID: core.ActionID{SpellID: 31834},
+		Expires:  sim.CurrentTime + time.Second*15,
+		OnCast: func(sim *core.Simulation, cast *core.Cast) {
+			if IsHolyLight(cast.ActionID.SpellID) {
+				cast.CastTime -= time.Millisecond * 500
+			}
+		},
+		OnCastComplete: func(sim *core.Simulation, cast *core.Cast) {
+			if IsHolyLight(cast.ActionID.SpellID) {
+				paladin.RemoveAura(sim, LightsGraceAuraID)
+			}
+		},
+	}
+}
@@ -19,6 +19,12 @@ type Paladin struct {
 	crusaderStrikeSpell    core.ActiveMeleeAbility
 	sealOfBlood            core.SimpleCast
 	sealOfCommand          core.SimpleCast
+
+	// Keyed by spell rank.
+	holyLightCastTemplates    map[int32]core.SimpleHealTemplate
+	holyLightSpell            core.SimpleHeal
+	flashOfLightCastTemplates map[int32]core.SimpleHealTemplate
+	flashOfLightSpell         core.SimpleHeal
 }
 
 // Implemented by each Paladin spec.
@@ -42,6 +48,8 @@ func (paladin *Paladin) AddPartyBuffs(partyBuffs *proto.PartyBuffs) {
 func (paladin *Paladin) Init(sim *core.Simulation) {
 	paladin.crusaderStrikeTemplate = paladin.newCrusaderStrikeTemplate(sim)
 	paladin.consecrationTemplate = paladin.newConsecrationTemplate(sim)
+	paladin.holyLightCastTemplates = newHealTemplates(HolyLightRanks, paladin.newHolyLightTemplate)
+	paladin.flashOfLightCastTemplates = newHealTemplates(FlashOfLightRanks, paladin.newFlashOfLightTemplate)
 }
 
 func (paladin *Paladin) Reset(sim *core.Simulation) {
@@ -56,6 +64,16 @@ func NewPaladin(character core.Character, talents proto.PaladinTalents) *Paladin
 
 	paladin.EnableManaBar()
 
+	paladin.AddStatDependency(stats.StatDependency{
+		SourceStat:   stats.Intellect,
+		ModifiedStat: stats.SpellCrit,
+		Modifier: func(intellect float64, spellCrit float64) float64 {
+			return spellCrit + (intellect/80)*core.SpellCritRatingPerCritChance
+		},
+	})
+
+	paladin.applyTalents()
+
 	paladin.setupSealOfBlood()
 	paladin.setupSealOfCommand()
 
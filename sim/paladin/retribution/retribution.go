@@ -24,6 +24,7 @@ func RegisterRetributionPaladin() {
 
 func NewRetributionPaladin(character core.Character, options proto.Player) *RetributionPaladin {
 	retOptions := options.GetRetributionPaladin()
+	character.RejectAPLRotation("Retribution Paladin")
 
 	ret := &RetributionPaladin{
 		Paladin:  paladin.NewPaladin(character, *retOptions.Talents),
@@ -52,6 +53,9 @@ func (ret *RetributionPaladin) Reset(sim *core.Simulation) {
 	ret.Paladin.Reset(sim)
 }
 
+// Retribution doesn't implement core.MovingAgent. All of its attacks need
+// melee range, so while moving it waits for the movement to end, and its
+// auto attacks are delayed until then.
 func (ret *RetributionPaladin) OnGCDReady(sim *core.Simulation) {
 	ret.tryUseGCD(sim)
 }
@@ -67,7 +71,7 @@ func (ret *RetributionPaladin) tryUseGCD(sim *core.Simulation) {
 		return /// wtf?
 	}
 
-	target := sim.GetPrimaryTarget()
+	target := ret.CurrentTarget(sim)
 
 	// check if we can use crusader strike
 	if !ret.IsOnCD(paladin.CrusaderStrikeCD, sim.CurrentTime) {
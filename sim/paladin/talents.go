@@ -0,0 +1,73 @@
+package paladin
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+func (paladin *Paladin) applyTalents() {
+	paladin.registerDivineFavorCD()
+
+	if paladin.Talents.DivineIntellect > 0 {
+		bonus := 0.02 * float64(paladin.Talents.DivineIntellect)
+		paladin.AddStatDependency(stats.StatDependency{
+			SourceStat:   stats.Intellect,
+			ModifiedStat: stats.Intellect,
+			Modifier: func(intellect float64, _ float64) float64 {
+				return intellect + intellect*bonus
+			},
+		})
+	}
+}
+
+var DivineFavorAuraID = core.NewAuraID()
+var DivineFavorCooldownID = core.NewCooldownID()
+
+func (paladin *Paladin) registerDivineFavorCD() {
+	if !paladin.Talents.DivineFavor {
+		return
+	}
+
+	actionID := core.ActionID{SpellID: 20216, CooldownID: DivineFavorCooldownID}
+	manaCost := 118.0
+	cooldown := time.Minute * 2
+
+	paladin.AddMajorCooldown(core.MajorCooldown{
+		ActionID:   actionID,
+		CooldownID: DivineFavorCooldownID,
+		Cooldown:   cooldown,
+		Type:       core.CooldownTypeHealing,
+		CanActivate: func(sim *core.Simulation, character *core.Character) bool {
+			return character.CurrentMana() >= manaCost
+		},
+		ShouldActivate: func(sim *core.Simulation, character *core.Character) bool {
+			return true
+		},
+		ActivationFactory: func(sim *core.Simulation) core.CooldownActivation {
+			return func(sim *core.Simulation, character *core.Character) {
+				character.SpendMana(sim, manaCost, actionID)
+				character.AddAura(sim, core.Aura{
+					ID:       DivineFavorAuraID,
+					ActionID: actionID,
+					Expires:  core.NeverExpires,
+				})
+				character.SetCD(DivineFavorCooldownID, sim.CurrentTime+cooldown)
+				character.Metrics.AddInstantCast(actionID)
+			}
+		},
+	})
+}
+
+// Divine Favor makes the next heal a guaranteed crit.
+func (paladin *Paladin) applyDivineFavor(heal *core.SimpleHeal) {
+	if !paladin.HasAura(DivineFavorAuraID) {
+		return
+	}
+
+	heal.Effect.BonusCritRating += 100 * core.SpellCritRatingPerCritChance
+	heal.OnCastComplete = func(sim *core.Simulation, cast *core.Cast) {
+		paladin.RemoveAura(sim, DivineFavorAuraID)
+	}
+}
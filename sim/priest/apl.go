@@ -0,0 +1,117 @@
+package priest
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+)
+
+// Registers the actions which APL rotations can use.
+func (priest *Priest) registerAPLActions() {
+	priest.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   core.ActionID{SpellID: SpellIDMindBlast},
+		CooldownID: MBCooldownID,
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return priest.NewMindBlast(sim, target).Cast(sim)
+		},
+	})
+	priest.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   core.ActionID{SpellID: SpellIDShadowWordDeath},
+		CooldownID: SWDCooldownID,
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return priest.NewShadowWordDeath(sim, target).Cast(sim)
+		},
+	})
+	priest.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDShadowWordPain},
+		DotRemaining: func(sim *core.Simulation, target *core.Target) time.Duration {
+			return priest.SWPSpellOn(target).Effect.DotInput.TimeRemaining(sim)
+		},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			// The spell object is reused, so it can't be recast until the old DoT is done.
+			if priest.SWPSpellOn(target).IsInUse() {
+				return false
+			}
+			return priest.NewShadowWordPain(sim, target).Cast(sim)
+		},
+	})
+	priest.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   core.ActionID{SpellID: SpellIDDevouringPlague},
+		CooldownID: DevouringPlagueCooldownID,
+		DotRemaining: func(sim *core.Simulation, target *core.Target) time.Duration {
+			if priest.DevouringPlagueSpell.Effect.Target != target {
+				return 0
+			}
+			return priest.DevouringPlagueSpell.Effect.DotInput.TimeRemaining(sim)
+		},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			if priest.DevouringPlagueSpell.IsInUse() {
+				return false
+			}
+			return priest.NewDevouringPlague(sim, target).Cast(sim)
+		},
+	})
+	priest.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDSmite},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return priest.NewSmite(sim, target).Cast(sim)
+		},
+	})
+	priest.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   core.ActionID{SpellID: SpellIDHolyFire},
+		CooldownID: HolyFireCooldownID,
+		DotRemaining: func(sim *core.Simulation, target *core.Target) time.Duration {
+			if priest.HolyFireSpell.Effect.Target != target {
+				return 0
+			}
+			return priest.HolyFireSpell.Effect.DotInput.TimeRemaining(sim)
+		},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			if priest.HolyFireSpell.IsInUse() {
+				return false
+			}
+			return priest.NewHolyFire(sim, target).Cast(sim)
+		},
+	})
+	priest.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   core.ActionID{SpellID: SpellIDStarshards},
+		CooldownID: SSCooldownID,
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return priest.NewStarshards(sim, target).Cast(sim)
+		},
+	})
+
+	if priest.Talents.VampiricTouch {
+		priest.RegisterAPLAction(core.APLActionConfig{
+			ActionID: core.ActionID{SpellID: VampiricTouchActionID.SpellID},
+			DotRemaining: func(sim *core.Simulation, target *core.Target) time.Duration {
+				if priest.VTSpell.Effect.Target != target {
+					return 0
+				}
+				return priest.VTSpell.Effect.DotInput.TimeRemaining(sim)
+			},
+			Execute: func(sim *core.Simulation, target *core.Target) bool {
+				if priest.VTSpellCasting.IsInUse() {
+					return false
+				}
+				return priest.NewVampiricTouch(sim, target).Cast(sim)
+			},
+		})
+	}
+
+	if priest.Talents.MindFlay {
+		// The tag is the number of ticks to channel, with 0 meaning a full channel.
+		for numTicks := 0; numTicks <= 3; numTicks++ {
+			channelTicks := numTicks
+			if channelTicks == 0 {
+				channelTicks = 3
+			}
+			priest.RegisterAPLAction(core.APLActionConfig{
+				ActionID: core.ActionID{SpellID: SpellIDMindFlay, Tag: int32(numTicks)},
+				Execute: func(sim *core.Simulation, target *core.Target) bool {
+					return priest.NewMindFlay(sim, target, channelTicks).Cast(sim)
+				},
+			})
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package priest
+
+import (
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const SpellIDCircleOfHealing int32 = 34866
+
+// Circle of Healing heals up to 5 members of the target's party.
+const circleOfHealingMaxTargets = 5
+
+func (priest *Priest) newCircleOfHealingTemplate(sim *core.Simulation) core.SimpleHealTemplate {
+	effect := priest.newHealEffect()
+	effect.DirectInput = core.DirectHealInput{
+		MinBaseHealing: 409,
+		MaxBaseHealing: 451,
+		// The usual instant cast coefficient, split between the targets.
+		SpellCoefficient: 1.5 / 3.5 / 2,
+	}
+
+	effects := make([]core.HealEffect, 0, circleOfHealingMaxTargets)
+	for i := 0; i < circleOfHealingMaxTargets; i++ {
+		effects = append(effects, effect)
+	}
+
+	return core.NewSimpleHealTemplate(core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: core.Cast{
+				ActionID:       core.ActionID{SpellID: SpellIDCircleOfHealing},
+				Character:      &priest.Character,
+				SpellSchool:    stats.HolySpellPower,
+				BaseManaCost:   450,
+				ManaCost:       450,
+				GCD:            core.GCDDefault,
+				CritMultiplier: core.HealCritMultiplier,
+			},
+		},
+		Effects: effects,
+	})
+}
+
+// Casts Circle of Healing on the given party, healing its most injured members.
+func (priest *Priest) NewCircleOfHealing(sim *core.Simulation, party *core.Party) *core.SimpleHeal {
+	// Initialize cast from precomputed template.
+	coh := &priest.circleOfHealingSpell
+	priest.circleOfHealingCastTemplate.Apply(coh)
+
+	// Set dynamic fields, i.e. the stuff we couldn't precompute.
+	coh.SmartTargets = priest.partyCharacters[party.Index]
+	coh.Init(sim)
+
+	return coh
+}
+
+// Returns the characters in each party, indexed by party index.
+func newPartyCharacters(sim *core.Simulation) [][]*core.Character {
+	partyCharacters := make([][]*core.Character, len(sim.Raid.Parties))
+	for _, party := range sim.Raid.Parties {
+		for _, player := range party.Players {
+			partyCharacters[party.Index] = append(partyCharacters[party.Index], player.GetCharacter())
+		}
+	}
+	return partyCharacters
+}
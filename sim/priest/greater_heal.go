@@ -0,0 +1,51 @@
+package priest
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const SpellIDGreaterHeal int32 = 25213
+
+func (priest *Priest) newGreaterHealTemplate(sim *core.Simulation) core.SimpleHealTemplate {
+	baseCast := core.Cast{
+		ActionID:       core.ActionID{SpellID: SpellIDGreaterHeal},
+		Character:      &priest.Character,
+		SpellSchool:    stats.HolySpellPower,
+		BaseManaCost:   825,
+		ManaCost:       825,
+		CastTime:       time.Millisecond * 3000,
+		GCD:            core.GCDDefault,
+		CritMultiplier: core.HealCritMultiplier,
+	}
+	baseCast.ManaCost -= baseCast.BaseManaCost * 0.05 * float64(priest.Talents.ImprovedHealing)
+	baseCast.CastTime -= time.Millisecond * 100 * time.Duration(priest.Talents.DivineFury)
+
+	effect := priest.newHealEffect()
+	effect.DirectInput = core.DirectHealInput{
+		MinBaseHealing:   2396,
+		MaxBaseHealing:   2784,
+		SpellCoefficient: 3.0/3.5 + 0.04*float64(priest.Talents.EmpoweredHealing),
+	}
+
+	return core.NewSimpleHealTemplate(core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: baseCast,
+		},
+		Effect: effect,
+	})
+}
+
+func (priest *Priest) NewGreaterHeal(sim *core.Simulation, target *core.Character) *core.SimpleHeal {
+	// Initialize cast from precomputed template.
+	gh := &priest.greaterHealSpell
+	priest.greaterHealCastTemplate.Apply(gh)
+
+	// Set dynamic fields, i.e. the stuff we couldn't precompute.
+	gh.Effect.Target = target
+	gh.Init(sim)
+
+	return gh
+}
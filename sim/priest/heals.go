@@ -0,0 +1,31 @@
+package priest
+
+import (
+	"github.com/wowsims/tbc/sim/core"
+)
+
+// Helper for precomputing heal effects, with the talents shared by all priest heals.
+func (priest *Priest) newHealEffect() core.HealEffect {
+	effect := core.HealEffect{
+		HealingMultiplier: 1,
+	}
+
+	effect.HealingMultiplier *= 1 + 0.02*float64(priest.Talents.SpiritualHealing)
+	effect.BonusCritRating += float64(priest.Talents.HolySpecialization) * 1 * core.SpellCritRatingPerCritChance
+
+	if priest.Talents.SurgeOfLight > 0 {
+		effect.OnHeal = func(sim *core.Simulation, spellCast *core.SpellCast, healEffect *core.HealEffect) {
+			if healEffect.Crit {
+				priest.trySurgeOfLight(sim)
+			}
+		}
+	}
+
+	return effect
+}
+
+// Heals are indexed by raid index, so 1 hot of each type can be kept up on
+// every player.
+func newHealsByRaidIndex(sim *core.Simulation) []core.SimpleHeal {
+	return make([]core.SimpleHeal, len(sim.Raid.Parties)*5)
+}
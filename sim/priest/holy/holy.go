@@ -0,0 +1,162 @@
+package holy
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/priest"
+)
+
+func RegisterHolyPriest() {
+	core.RegisterAgentFactory(
+		proto.Player_HolyPriest{},
+		func(character core.Character, options proto.Player) core.Agent {
+			return NewHolyPriest(character, options)
+		},
+		func(player *proto.Player, spec interface{}) {
+			playerSpec, ok := spec.(*proto.Player_HolyPriest)
+			if !ok {
+				panic("Invalid spec value for Holy Priest!")
+			}
+			player.Spec = playerSpec
+		},
+	)
+}
+
+// How long to wait before checking again, when nobody needs healing.
+const idleWaitDuration = time.Millisecond * 500
+
+func NewHolyPriest(character core.Character, options proto.Player) *HolyPriest {
+	holyOptions := options.GetHolyPriest()
+	character.RejectAPLRotation("Holy Priest")
+
+	selfBuffs := priest.SelfBuffs{}
+	if holyOptions.Options.PowerInfusionTarget != nil {
+		selfBuffs.PowerInfusionTarget = *holyOptions.Options.PowerInfusionTarget
+	} else {
+		selfBuffs.PowerInfusionTarget.TargetIndex = -1
+	}
+
+	prayerOfMendingTarget := proto.RaidTarget{TargetIndex: -1}
+	if holyOptions.Rotation.PrayerOfMendingTarget != nil {
+		prayerOfMendingTarget = *holyOptions.Rotation.PrayerOfMendingTarget
+	}
+
+	return &HolyPriest{
+		Priest:                    priest.New(character, selfBuffs, *holyOptions.Talents),
+		rotation:                  *holyOptions.Rotation,
+		prayerOfMendingRaidTarget: prayerOfMendingTarget,
+	}
+}
+
+type HolyPriest struct {
+	*priest.Priest
+
+	rotation proto.HolyPriest_Rotation
+
+	prayerOfMendingRaidTarget proto.RaidTarget
+	prayerOfMendingTarget     *core.Character
+}
+
+func (hpriest *HolyPriest) GetPriest() *priest.Priest {
+	return hpriest.Priest
+}
+
+func (hpriest *HolyPriest) Init(sim *core.Simulation) {
+	hpriest.Priest.Init(sim)
+
+	if prayerOfMendingTargetAgent := sim.Raid.GetPlayerFromRaidTarget(hpriest.prayerOfMendingRaidTarget); prayerOfMendingTargetAgent != nil {
+		hpriest.prayerOfMendingTarget = prayerOfMendingTargetAgent.GetCharacter()
+	}
+}
+
+func (hpriest *HolyPriest) Reset(sim *core.Simulation) {
+	hpriest.Priest.Reset(sim)
+}
+
+func (hpriest *HolyPriest) OnGCDReady(sim *core.Simulation) {
+	hpriest.tryUseGCD(sim)
+}
+
+func (hpriest *HolyPriest) OnManaTick(sim *core.Simulation) {
+	if hpriest.FinishedWaitingForManaAndGCDReady(sim) {
+		hpriest.tryUseGCD(sim)
+	}
+}
+
+func (hpriest *HolyPriest) tryUseGCD(sim *core.Simulation) {
+	if hpriest.prayerOfMendingTarget != nil && !hpriest.IsOnCD(priest.PrayerOfMendingCooldownID, sim.CurrentTime) {
+		prayerOfMending := hpriest.NewPrayerOfMending(sim, hpriest.prayerOfMendingTarget)
+		if success := prayerOfMending.StartCast(sim); !success {
+			hpriest.WaitForMana(sim, prayerOfMending.GetManaCost())
+		}
+		return
+	}
+
+	if party := hpriest.circleOfHealingParty(sim); party != nil {
+		hpriest.castHeal(sim, hpriest.NewCircleOfHealing(sim, party))
+		return
+	}
+
+	// Heal the most injured player who isn't already covered by Renew.
+	characters := sim.Raid.AllCharacters()
+	var healed []*core.Character
+	for {
+		target := core.MostInjuredCharacter(characters, healed)
+		if target == nil || !hpriest.needsHealing(target, hpriest.rotation.HealThreshold) {
+			break
+		}
+
+		if hpriest.needsHealing(target, hpriest.rotation.GreaterHealThreshold) {
+			hpriest.castHeal(sim, hpriest.NewGreaterHeal(sim, target))
+			return
+		}
+		if !hpriest.RenewOn(target).Effect.HotInput.IsTicking(sim) {
+			hpriest.castHeal(sim, hpriest.NewRenew(sim, target))
+			return
+		}
+		healed = append(healed, target)
+	}
+
+	hpriest.WaitUntil(sim, sim.CurrentTime+idleWaitDuration)
+}
+
+// Returns whether the target is missing at least the given fraction of their max health.
+func (hpriest *HolyPriest) needsHealing(target *core.Character, threshold float64) bool {
+	return target.MissingHealth() > 0 && target.MissingHealth() >= target.MaxHealth()*threshold
+}
+
+// Returns the party with the most players needing healing, if there are
+// enough of them to be worth a Circle of Healing.
+func (hpriest *HolyPriest) circleOfHealingParty(sim *core.Simulation) *core.Party {
+	if !hpriest.Talents.CircleOfHealing || hpriest.rotation.CircleOfHealingMinTargets <= 0 {
+		return nil
+	}
+
+	var bestParty *core.Party
+	bestCount := int32(0)
+	for _, party := range sim.Raid.Parties {
+		count := int32(0)
+		for _, player := range party.Players {
+			if hpriest.needsHealing(player.GetCharacter(), hpriest.rotation.HealThreshold) {
+				count++
+			}
+		}
+		if count > bestCount {
+			bestParty = party
+			bestCount = count
+		}
+	}
+
+	if bestCount < hpriest.rotation.CircleOfHealingMinTargets {
+		return nil
+	}
+	return bestParty
+}
+
+func (hpriest *HolyPriest) castHeal(sim *core.Simulation, heal *core.SimpleHeal) {
+	if success := heal.Cast(sim); !success {
+		hpriest.WaitForMana(sim, heal.GetManaCost())
+	}
+}
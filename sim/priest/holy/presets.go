@@ -0,0 +1,79 @@
+package holy
+
+import (
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+var StandardTalents = &proto.PriestTalents{
+	InnerFocus:         true,
+	Meditation:         3,
+	MentalAgility:      5,
+	ImprovedRenew:      3,
+	HolySpecialization: 5,
+	DivineFury:         5,
+	ImprovedHealing:    3,
+	SpiritualGuidance:  5,
+	SpiritualHealing:   5,
+	EmpoweredHealing:   5,
+	CircleOfHealing:    true,
+}
+
+var PlayerOptionsStandard = &proto.Player_HolyPriest{
+	HolyPriest: &proto.HolyPriest{
+		Talents: StandardTalents,
+		Options: &proto.HolyPriest_Options{},
+		Rotation: &proto.HolyPriest_Rotation{
+			HealThreshold:             0.05,
+			GreaterHealThreshold:      0.4,
+			CircleOfHealingMinTargets: 3,
+			PrayerOfMendingTarget:     &proto.RaidTarget{TargetIndex: 0},
+		},
+	},
+}
+
+var FullRaidBuffs = &proto.RaidBuffs{
+	ArcaneBrilliance: true,
+	GiftOfTheWild:    proto.TristateEffect_TristateEffectImproved,
+}
+var FullIndividualBuffs = &proto.IndividualBuffs{
+	BlessingOfKings:  true,
+	BlessingOfWisdom: proto.TristateEffect_TristateEffectImproved,
+}
+
+var FullConsumes = &proto.Consumes{
+	Food:            proto.Food_FoodBlackenedBasilisk,
+	DefaultPotion:   proto.Potions_SuperManaPotion,
+	DefaultConjured: proto.Conjured_ConjuredDarkRune,
+}
+
+var P1Gear = items.EquipmentSpecFromStrings([]items.ItemStringSpec{
+	items.ItemStringSpec{
+		Name: "Light-Collar of the Incarnate",
+		Gems: []string{
+			"Bracing Earthstorm Diamond",
+			"Royal Nightseye",
+		},
+	},
+	items.ItemStringSpec{
+		Name: "Light-Mantle of the Incarnate",
+	},
+	items.ItemStringSpec{
+		Name: "Robes of the Incarnate",
+	},
+	items.ItemStringSpec{
+		Name: "Handwraps of the Incarnate",
+	},
+	items.ItemStringSpec{
+		Name: "Trousers of the Incarnate",
+	},
+	items.ItemStringSpec{
+		Name: "Light's Justice",
+	},
+	items.ItemStringSpec{
+		Name: "Lower City Prayerbook",
+	},
+	items.ItemStringSpec{
+		Name: "Essence of the Martyr",
+	},
+})
@@ -0,0 +1,65 @@
+package priest
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+)
+
+func (priest *Priest) applyTalentsToHolySpell(cast *core.Cast, effect *core.SpellHitEffect) {
+	effect.BonusSpellCritRating += float64(priest.Talents.HolySpecialization) * 1 * core.SpellCritRatingPerCritChance
+	effect.StaticDamageMultiplier *= 1 + 0.05*float64(priest.Talents.SearingLight)
+	cast.CastTime -= time.Millisecond * 100 * time.Duration(priest.Talents.DivineFury)
+}
+
+var SurgeOfLightAuraID = core.NewAuraID()
+var SurgeOfLightProcAuraID = core.NewAuraID()
+
+var SurgeOfLightActionID = core.ActionID{SpellID: 33151}
+
+const surgeOfLightDuration = time.Second * 10
+
+// Surge of Light gives spell crits a chance to make the next Smite instant and
+// free, but the empowered Smite can't crit.
+func (priest *Priest) applySurgeOfLight() {
+	if priest.Talents.SurgeOfLight == 0 {
+		return
+	}
+
+	priest.Character.AddPermanentAura(func(sim *core.Simulation) core.Aura {
+		return core.Aura{
+			ID: SurgeOfLightAuraID,
+			OnSpellHit: func(sim *core.Simulation, spellCast *core.SpellCast, spellEffect *core.SpellEffect) {
+				if spellEffect.Crit {
+					priest.trySurgeOfLight(sim)
+				}
+			},
+		}
+	})
+}
+
+func (priest *Priest) trySurgeOfLight(sim *core.Simulation) {
+	if sim.RandomFloat("Surge of Light") > 0.25*float64(priest.Talents.SurgeOfLight) {
+		return
+	}
+
+	priest.ReplaceAura(sim, core.Aura{
+		ID:       SurgeOfLightProcAuraID,
+		ActionID: SurgeOfLightActionID,
+		Expires:  sim.CurrentTime + surgeOfLightDuration,
+		OnCast: func(sim *core.Simulation, cast *core.Cast) {
+			if cast.ActionID.SpellID != SpellIDSmite {
+				return
+			}
+			cast.CastTime = 0
+			cast.ManaCost = 0
+			// Pushes the crit chance below 0, so the Smite can't crit.
+			cast.BonusCritRating -= 100 * core.SpellCritRatingPerCritChance
+		},
+		OnCastComplete: func(sim *core.Simulation, cast *core.Cast) {
+			if cast.ActionID.SpellID == SpellIDSmite {
+				priest.RemoveAura(sim, SurgeOfLightProcAuraID)
+			}
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package priest
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const SpellIDHolyFire int32 = 25384
+
+var HolyFireCooldownID = core.NewCooldownID()
+var HolyFireDebuffID = core.NewDebuffID()
+
+func (priest *Priest) newHolyFireTemplate(sim *core.Simulation) core.SimpleSpellTemplate {
+	baseCast := core.Cast{
+		ActionID: core.ActionID{
+			SpellID:    SpellIDHolyFire,
+			CooldownID: HolyFireCooldownID,
+		},
+		Character:      &priest.Character,
+		SpellSchool:    stats.HolySpellPower,
+		BaseManaCost:   290,
+		ManaCost:       290,
+		CastTime:       time.Millisecond * 3500,
+		GCD:            core.GCDDefault,
+		Cooldown:       time.Second * 10,
+		CritMultiplier: priest.DefaultSpellCritMultiplier(),
+	}
+
+	effect := core.SpellHitEffect{
+		SpellEffect: core.SpellEffect{
+			DamageMultiplier:       1,
+			StaticDamageMultiplier: 1,
+			ThreatMultiplier:       1,
+		},
+		DirectInput: core.DirectDamageInput{
+			MinBaseDamage:    412,
+			MaxBaseDamage:    523,
+			SpellCoefficient: 0.857,
+		},
+		DotInput: core.DotDamageInput{
+			NumberOfTicks:        7,
+			TickLength:           time.Second,
+			TickBaseDamage:       165.0 / 7,
+			TickSpellCoefficient: 0.165 / 7,
+			DebuffID:             HolyFireDebuffID,
+		},
+	}
+
+	priest.applyTalentsToHolySpell(&baseCast, &effect)
+
+	return core.NewSimpleSpellTemplate(core.SimpleSpell{
+		SpellCast: core.SpellCast{
+			Cast: baseCast,
+		},
+		Effect: effect,
+	})
+}
+
+func (priest *Priest) NewHolyFire(sim *core.Simulation, target *core.Target) *core.SimpleSpell {
+	// Initialize cast from precomputed template.
+	hf := &priest.HolyFireSpell
+
+	priest.holyFireCastTemplate.Apply(hf)
+
+	// Set dynamic fields, i.e. the stuff we couldn't precompute.
+	hf.Effect.Target = target
+	hf.Init(sim)
+
+	return hf
+}
@@ -0,0 +1,79 @@
+package priest
+
+import (
+	"github.com/wowsims/tbc/sim/core"
+)
+
+var PowerInfusionCooldownID = core.NewCooldownID()
+
+// Power Infusion is cast on another raid member, so that player receives the
+// buff at the moment it's cast instead of from a separate approximation.
+func (priest *Priest) registerPowerInfusionCD() {
+	if !priest.Talents.PowerInfusion {
+		return
+	}
+
+	actionID := core.ActionID{SpellID: 10060, CooldownID: PowerInfusionCooldownID, Tag: int32(priest.RaidIndex)}
+	baseManaCost := priest.BaseMana() * 0.16
+
+	var powerInfusionTarget *core.Character
+
+	priest.AddMajorCooldown(core.MajorCooldown{
+		ActionID:   actionID,
+		CooldownID: PowerInfusionCooldownID,
+		Cooldown:   core.PowerInfusionCD,
+		UsesGCD:    true,
+		Type:       core.CooldownTypeDPS,
+		CanActivate: func(sim *core.Simulation, character *core.Character) bool {
+			if powerInfusionTarget == nil {
+				return false
+			}
+
+			if character.CurrentMana() < baseManaCost {
+				return false
+			}
+
+			// If target already has another power infusion, don't cast.
+			if powerInfusionTarget.HasAura(core.PowerInfusionAuraID) {
+				return false
+			}
+
+			return true
+		},
+		ShouldActivate: func(sim *core.Simulation, character *core.Character) bool {
+			// Haste portion doesn't stack with Bloodlust, so prefer to wait.
+			if powerInfusionTarget.HasAura(core.BloodlustAuraID) {
+				return false
+			}
+
+			return true
+		},
+		ActivationFactory: func(sim *core.Simulation) core.CooldownActivation {
+			powerInfusionTarget = nil
+			powerInfusionTargetAgent := sim.Raid.GetPlayerFromRaidTarget(priest.SelfBuffs.PowerInfusionTarget)
+			if powerInfusionTargetAgent != nil {
+				powerInfusionTarget = powerInfusionTargetAgent.GetCharacter()
+			}
+
+			castTemplate := core.SimpleCast{
+				Cast: core.Cast{
+					ActionID:     actionID,
+					Character:    priest.GetCharacter(),
+					BaseManaCost: baseManaCost,
+					ManaCost:     baseManaCost,
+					GCD:          core.GCDDefault,
+					Cooldown:     core.PowerInfusionCD,
+					OnCastComplete: func(sim *core.Simulation, cast *core.Cast) {
+						core.AddPowerInfusionAura(sim, powerInfusionTarget, actionID.Tag)
+					},
+				},
+			}
+
+			return func(sim *core.Simulation, character *core.Character) {
+				cast := castTemplate
+				cast.Init(sim)
+				cast.StartCast(sim)
+			}
+		},
+	})
+}
@@ -0,0 +1,132 @@
+package priest
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const SpellIDPrayerOfMending int32 = 33076
+
+var PrayerOfMendingCooldownID = core.NewCooldownID()
+
+// Prayer of Mending is a buff on another player, so all priests share this ID,
+// the same way Earth Shield works.
+var PrayerOfMendingAuraID = core.NewAuraID()
+
+const prayerOfMendingCharges = 5
+const prayerOfMendingDuration = time.Second * 30
+
+func (priest *Priest) newPrayerOfMendingTemplate(sim *core.Simulation) core.SimpleCast {
+	baseManaCost := 390.0
+	return core.SimpleCast{
+		Cast: core.Cast{
+			ActionID: core.ActionID{
+				SpellID:    SpellIDPrayerOfMending,
+				CooldownID: PrayerOfMendingCooldownID,
+			},
+			Character:    &priest.Character,
+			SpellSchool:  stats.HolySpellPower,
+			BaseManaCost: baseManaCost,
+			ManaCost:     baseManaCost,
+			GCD:          core.GCDDefault,
+			Cooldown:     time.Second * 10,
+		},
+	}
+}
+
+// The heal from each charge of Prayer of Mending. These use a separate tag so
+// they show up separately from the casts in metrics.
+func (priest *Priest) newPrayerOfMendingHealTemplate(sim *core.Simulation) core.SimpleHealTemplate {
+	effect := priest.newHealEffect()
+	effect.DirectInput = core.DirectHealInput{
+		MinBaseHealing:   800,
+		MaxBaseHealing:   800,
+		SpellCoefficient: 1.5 / 3.5,
+	}
+
+	return core.NewSimpleHealTemplate(core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: core.Cast{
+				ActionID:       core.ActionID{SpellID: SpellIDPrayerOfMending, Tag: 1},
+				Character:      &priest.Character,
+				SpellSchool:    stats.HolySpellPower,
+				IgnoreManaCost: true,
+				IsPhantom:      true,
+				CritMultiplier: core.HealCritMultiplier,
+			},
+		},
+		Effect: effect,
+	})
+}
+
+// Casts Prayer of Mending on the target, removing this priest's previous
+// Prayer of Mending if it's still up.
+func (priest *Priest) NewPrayerOfMending(sim *core.Simulation, target *core.Character) *core.SimpleCast {
+	priest.prayerOfMendingSpell = priest.prayerOfMendingCastTemplate
+	priest.prayerOfMendingSpell.OnCastComplete = func(sim *core.Simulation, cast *core.Cast) {
+		if priest.prayerOfMendingTarget != nil && priest.prayerOfMendingTarget.HasAura(PrayerOfMendingAuraID) {
+			priest.prayerOfMendingTarget.RemoveAura(sim, PrayerOfMendingAuraID)
+		}
+		priest.prayerOfMendingJump(sim, target, prayerOfMendingCharges)
+	}
+	priest.prayerOfMendingSpell.Init(sim)
+	return &priest.prayerOfMendingSpell
+}
+
+func (priest *Priest) prayerOfMendingJump(sim *core.Simulation, target *core.Character, charges int32) {
+	priest.prayerOfMendingTarget = target
+	consumed := false
+	target.ReplaceAura(sim, core.Aura{
+		ID:       PrayerOfMendingAuraID,
+		ActionID: core.ActionID{SpellID: SpellIDPrayerOfMending},
+		Expires:  sim.CurrentTime + prayerOfMendingDuration,
+		Stacks:   charges,
+		OnDamageTaken: func(sim *core.Simulation, character *core.Character, damage float64) {
+			if consumed {
+				return
+			}
+
+			heal := &priest.prayerOfMendingHeal
+			priest.prayerOfMendingHealTemplate.Apply(heal)
+			heal.Effect.Target = character
+			heal.Init(sim)
+			heal.Cast(sim)
+
+			// Can't remove auras while the OnDamageTaken callbacks are being iterated.
+			consumed = true
+			character.RemoveAuraOnNextAdvance(sim, PrayerOfMendingAuraID)
+			priest.prayerOfMendingTarget = nil
+
+			if charges > 1 {
+				if nextTarget := prayerOfMendingJumpTarget(sim, character); nextTarget != nil {
+					priest.prayerOfMendingJump(sim, nextTarget, charges-1)
+				}
+			}
+		},
+	})
+}
+
+// Prayer of Mending jumps to the most injured other player, or any other player
+// if nobody is injured. Returns nil if there's nobody else to jump to.
+func prayerOfMendingJumpTarget(sim *core.Simulation, from *core.Character) *core.Character {
+	characters := sim.Raid.AllCharacters()
+	if target := core.MostInjuredCharacter(characters, []*core.Character{from}); target != nil {
+		return target
+	}
+	for _, character := range characters {
+		if character != from {
+			return character
+		}
+	}
+	return nil
+}
+
+// Returns the number of Prayer of Mending charges left on the target.
+func PrayerOfMendingCharges(target *core.Character) int32 {
+	if !target.HasAura(PrayerOfMendingAuraID) {
+		return 0
+	}
+	return target.NumStacks(PrayerOfMendingAuraID)
+}
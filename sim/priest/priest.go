@@ -22,7 +22,8 @@ type Priest struct {
 	swdSpell        core.SimpleSpell
 	swdCastTemplate core.SimpleSpellTemplate
 
-	SWPSpell        core.SimpleSpell
+	// One per target, so Shadow Word: Pain can be kept up on several targets.
+	SWPSpells       []core.SimpleSpell
 	swpCastTemplate core.SimpleSpellTemplate
 
 	VTSpell        *core.SimpleSpell
@@ -37,10 +38,35 @@ type Priest struct {
 
 	StarshardsSpell    core.SimpleSpell
 	starshardsTemplate core.SimpleSpellTemplate
+
+	smiteSpell        core.SimpleSpell
+	smiteCastTemplate core.SimpleSpellTemplate
+
+	HolyFireSpell        core.SimpleSpell
+	holyFireCastTemplate core.SimpleSpellTemplate
+
+	greaterHealSpell        core.SimpleHeal
+	greaterHealCastTemplate core.SimpleHealTemplate
+
+	// One per player, indexed by raid index.
+	RenewHeals        []core.SimpleHeal
+	renewCastTemplate core.SimpleHealTemplate
+
+	circleOfHealingSpell        core.SimpleHeal
+	circleOfHealingCastTemplate core.SimpleHealTemplate
+	partyCharacters             [][]*core.Character
+
+	prayerOfMendingSpell        core.SimpleCast
+	prayerOfMendingCastTemplate core.SimpleCast
+	prayerOfMendingHeal         core.SimpleHeal
+	prayerOfMendingHealTemplate core.SimpleHealTemplate
+	prayerOfMendingTarget       *core.Character
 }
 
 type SelfBuffs struct {
 	UseShadowfiend bool
+
+	PowerInfusionTarget proto.RaidTarget
 }
 
 func (priest *Priest) GetCharacter() *core.Character {
@@ -65,17 +91,31 @@ func (priest *Priest) Init(sim *core.Simulation) {
 	priest.mindflayCastTemplate = priest.newMindflayTemplate(sim)
 	priest.mindblastCastTemplate = priest.newMindBlastTemplate(sim)
 	priest.swpCastTemplate = priest.newShadowWordPainTemplate(sim)
+	priest.SWPSpells = make([]core.SimpleSpell, sim.GetNumTargets())
 	priest.vtCastTemplate = priest.newVampiricTouchTemplate(sim)
 	priest.swdCastTemplate = priest.newShadowWordDeathTemplate(sim)
 	priest.shadowfiendTemplate = priest.newShadowfiendTemplate(sim)
 	priest.devouringPlagueTemplate = priest.newDevouringPlagueTemplate(sim)
 	priest.starshardsTemplate = priest.newStarshardsTemplate(sim)
+
+	priest.smiteCastTemplate = priest.newSmiteTemplate(sim)
+	priest.holyFireCastTemplate = priest.newHolyFireTemplate(sim)
+
+	priest.greaterHealCastTemplate = priest.newGreaterHealTemplate(sim)
+	priest.renewCastTemplate = priest.newRenewTemplate(sim)
+	priest.RenewHeals = newHealsByRaidIndex(sim)
+	priest.circleOfHealingCastTemplate = priest.newCircleOfHealingTemplate(sim)
+	priest.partyCharacters = newPartyCharacters(sim)
+	priest.prayerOfMendingCastTemplate = priest.newPrayerOfMendingTemplate(sim)
+	priest.prayerOfMendingHealTemplate = priest.newPrayerOfMendingHealTemplate(sim)
 }
 
 func (priest *Priest) Reset(newsim *core.Simulation) {
 	// These spells still need special cleanup because they're wierd.
 	priest.VTSpell = &core.SimpleSpell{}
 	priest.VTSpellCasting = &core.SimpleSpell{}
+
+	priest.prayerOfMendingTarget = nil
 }
 
 func New(char core.Character, selfBuffs SelfBuffs, talents proto.PriestTalents) *Priest {
@@ -95,6 +135,8 @@ func New(char core.Character, selfBuffs SelfBuffs, talents proto.PriestTalents)
 	})
 
 	priest.registerShadowfiendCD()
+	priest.registerPowerInfusionCD()
+	priest.registerAPLActions()
 	priest.applyTalents()
 
 	return priest
@@ -0,0 +1,52 @@
+package priest
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const SpellIDRenew int32 = 25222
+
+func (priest *Priest) newRenewTemplate(sim *core.Simulation) core.SimpleHealTemplate {
+	effect := priest.newHealEffect()
+	effect.HotInput = core.HotInput{
+		NumberOfTicks:        5,
+		TickLength:           time.Second * 3,
+		TickBaseHealing:      1110.0 / 5,
+		TickSpellCoefficient: 1.0 / 5,
+	}
+	effect.HealingMultiplier *= 1 + 0.05*float64(priest.Talents.ImprovedRenew)
+
+	return core.NewSimpleHealTemplate(core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: core.Cast{
+				ActionID:       core.ActionID{SpellID: SpellIDRenew},
+				Character:      &priest.Character,
+				SpellSchool:    stats.HolySpellPower,
+				BaseManaCost:   450,
+				ManaCost:       450,
+				GCD:            core.GCDDefault,
+				CritMultiplier: core.HealCritMultiplier,
+			},
+		},
+		Effect: effect,
+	})
+}
+
+func (priest *Priest) NewRenew(sim *core.Simulation, target *core.Character) *core.SimpleHeal {
+	// Initialize cast from precomputed template.
+	renew := priest.RenewOn(target)
+	priest.renewCastTemplate.Apply(renew)
+
+	// Set dynamic fields, i.e. the stuff we couldn't precompute.
+	renew.Effect.Target = target
+	renew.Init(sim)
+
+	return renew
+}
+
+func (priest *Priest) RenewOn(target *core.Character) *core.SimpleHeal {
+	return &priest.RenewHeals[target.RaidIndex]
+}
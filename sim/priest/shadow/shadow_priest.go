@@ -41,6 +41,11 @@ func NewShadowPriest(character core.Character, options proto.Player) *ShadowPrie
 	selfBuffs := priest.SelfBuffs{
 		UseShadowfiend: shadowOptions.Options.UseShadowfiend,
 	}
+	if shadowOptions.Options.PowerInfusionTarget != nil {
+		selfBuffs.PowerInfusionTarget = *shadowOptions.Options.PowerInfusionTarget
+	} else {
+		selfBuffs.PowerInfusionTarget.TargetIndex = -1
+	}
 
 	basePriest := priest.New(character, selfBuffs, *shadowOptions.Talents)
 	spriest := &ShadowPriest{
@@ -48,7 +53,16 @@ func NewShadowPriest(character core.Character, options proto.Player) *ShadowPrie
 		rotation: *shadowOptions.Rotation,
 	}
 
+	if spriest.Latency.ReactionTime == 0 && spriest.rotation.Latency > 0 {
+		// Legacy latency option, which varies from 0.66 - 1.33 of the given latency.
+		spriest.Latency.ReactionTime = core.DurationFromMillis(spriest.rotation.Latency)
+		if spriest.Latency.Jitter == 0 {
+			spriest.Latency.Jitter = core.DurationFromMillis(spriest.rotation.Latency * 0.33)
+		}
+	}
+
 	spriest.ApplyShadowOnHitEffects()
+	spriest.GivesVampiricTouchMana = spriest.Talents.VampiricTouch
 
 	return spriest
 }
@@ -85,9 +99,51 @@ func (spriest *ShadowPriest) OnManaTick(sim *core.Simulation) {
 	}
 }
 
+// Mind Blast, Mind Flay and Vampiric Touch can't be cast while moving, so
+// keep up Shadow Word: Pain and use the instant nukes.
+func (spriest *ShadowPriest) OnGCDReadyWhileMoving(sim *core.Simulation) {
+	target := spriest.CurrentTarget(sim)
+	var spell *core.SimpleSpell
+	if !spriest.SWPSpellOn(target).Effect.DotInput.IsTicking(sim) {
+		spell = spriest.NewShadowWordPain(sim, target)
+	} else if spriest.GetRemainingCD(priest.SWDCooldownID, sim.CurrentTime) == 0 {
+		spell = spriest.NewShadowWordDeath(sim, target)
+	} else if spriest.rotation.UseDevPlague && spriest.GetRemainingCD(priest.DevouringPlagueCooldownID, sim.CurrentTime) == 0 {
+		spell = spriest.NewDevouringPlague(sim, target)
+	}
+
+	if spell != nil {
+		if success := spell.Cast(sim); success {
+			return
+		}
+		spriest.WaitUntil(sim, spriest.MovementEndsAt())
+		return
+	}
+
+	waitUntil := core.MinDuration(spriest.MovementEndsAt(), spriest.CDReadyAt(priest.SWDCooldownID))
+	waitUntil = core.MinDuration(waitUntil, sim.CurrentTime+spriest.SWPSpellOn(target).Effect.DotInput.TimeRemaining(sim))
+	spriest.WaitUntil(sim, waitUntil)
+}
+
+// Returns another active target which needs Shadow Word: Pain, if
+// multi-dotting is enabled.
+func (spriest *ShadowPriest) multiDotTarget(sim *core.Simulation, currentTarget *core.Target) *core.Target {
+	if !spriest.rotation.MultiDot {
+		return nil
+	}
+
+	for i := int32(0); i < sim.GetNumTargets(); i++ {
+		target := sim.GetTarget(i)
+		if target != currentTarget && target.IsActive() && !spriest.SWPSpellOn(target).Effect.DotInput.IsTicking(sim) {
+			return target
+		}
+	}
+	return nil
+}
+
 func (spriest *ShadowPriest) tryUseGCD(sim *core.Simulation) {
 	if spriest.rotation.PrecastVt && sim.CurrentTime == 0 {
-		spell := spriest.NewVampiricTouch(sim, sim.GetPrimaryTarget())
+		spell := spriest.NewVampiricTouch(sim, spriest.CurrentTarget(sim))
 		spell.CastTime = 0
 		spell.GCD = 0
 		spell.Cast(sim)
@@ -109,8 +165,13 @@ func (spriest *ShadowPriest) tryUseGCD(sim *core.Simulation) {
 		spriest.VTSpellCasting = oldVT // will probably have one more tick
 	}
 
+	if spriest.HasAPLRotation() {
+		spriest.DoAPLRotation(sim)
+		return
+	}
+
 	// Activate shared behaviors
-	target := sim.GetPrimaryTarget()
+	target := spriest.CurrentTarget(sim)
 	var spell *core.SimpleSpell
 	var wait1 time.Duration
 	var wait2 time.Duration
@@ -125,8 +186,10 @@ func (spriest *ShadowPriest) tryUseGCD(sim *core.Simulation) {
 
 	if spriest.Talents.VampiricTouch && spriest.VTSpell.Effect.DotInput.TimeRemaining(sim) <= vtCastTime {
 		spell = spriest.NewVampiricTouch(sim, target)
-	} else if !spriest.SWPSpell.Effect.DotInput.IsTicking(sim) {
+	} else if !spriest.SWPSpellOn(target).Effect.DotInput.IsTicking(sim) {
 		spell = spriest.NewShadowWordPain(sim, target)
+	} else if multiDotTarget := spriest.multiDotTarget(sim, target); multiDotTarget != nil {
+		spell = spriest.NewShadowWordPain(sim, multiDotTarget)
 	} else if spriest.rotation.UseStarshards && spriest.GetRemainingCD(priest.SSCooldownID, sim.CurrentTime) == 0 {
 		spell = spriest.NewStarshards(sim, target)
 	} else if spriest.rotation.UseDevPlague && spriest.GetRemainingCD(priest.DevouringPlagueCooldownID, sim.CurrentTime) == 0 {
@@ -137,7 +200,7 @@ func (spriest *ShadowPriest) tryUseGCD(sim *core.Simulation) {
 			mbidx:  spriest.Character.GetRemainingCD(priest.MBCooldownID, sim.CurrentTime),
 			swdidx: spriest.Character.GetRemainingCD(priest.SWDCooldownID, sim.CurrentTime),
 			vtidx:  spriest.VTSpell.Effect.DotInput.TimeRemaining(sim) - vtCastTime,
-			swpidx: spriest.SWPSpell.Effect.DotInput.TimeRemaining(sim),
+			swpidx: spriest.SWPSpellOn(target).Effect.DotInput.TimeRemaining(sim),
 		}
 
 		if allCDs[mbidx] == 0 {
@@ -176,11 +239,10 @@ func (spriest *ShadowPriest) tryUseGCD(sim *core.Simulation) {
 			spell = spriest.NewMindFlay(sim, target, numTicks)
 
 			// if our channel is longer than GCD it will have human latency to end it beause you can't queue the next spell.
-			if wait > gcd && spriest.rotation.Latency > 0 {
-				base := spriest.rotation.Latency * 0.66
-				variation := base + sim.RandomFloat("spriest latency")*base // should vary from 0.66 - 1.33 of given latency
-				variation = core.MaxFloat(variation, 10)                    // no player can go under XXXms response time
-				spell.AfterCastDelay += time.Duration(variation) * time.Millisecond
+			if wait > gcd && spriest.Latency.ReactionTime > 0 {
+				reactionDelay := spriest.ReactionDelay(sim)
+				reactionDelay = core.MaxDuration(reactionDelay, time.Millisecond*10) // no player can go under XXXms response time
+				spell.AfterCastDelay += reactionDelay
 			}
 		}
 	} else {
@@ -188,7 +250,7 @@ func (spriest *ShadowPriest) tryUseGCD(sim *core.Simulation) {
 		mbcd := spriest.Character.GetRemainingCD(priest.MBCooldownID, sim.CurrentTime)
 		swdcd := spriest.Character.GetRemainingCD(priest.SWDCooldownID, sim.CurrentTime)
 		vtidx := spriest.VTSpell.Effect.DotInput.TimeRemaining(sim) - vtCastTime
-		swpidx := spriest.SWPSpell.Effect.DotInput.TimeRemaining(sim)
+		swpidx := spriest.SWPSpellOn(target).Effect.DotInput.TimeRemaining(sim)
 		wait1 = core.MinDuration(mbcd, swdcd)
 		wait2 = core.MinDuration(vtidx, swpidx)
 		wait = core.MinDuration(wait1, wait2)
@@ -220,6 +282,8 @@ func (spriest *ShadowPriest) BasicMindflayRotation(sim *core.Simulation, allCDs
 
 // Returns the number of MF ticks to use, or 0 to wait for next CD.
 func (spriest *ShadowPriest) IdealMindflayRotation(sim *core.Simulation, allCDs []time.Duration, gcd time.Duration, tickLength time.Duration) int {
+	swpSpell := spriest.SWPSpellOn(spriest.CurrentTarget(sim))
+
 	nextCD := core.NeverExpires
 	nextIdx := -1
 	for i, v := range allCDs {
@@ -250,7 +314,7 @@ func (spriest *ShadowPriest) IdealMindflayRotation(sim *core.Simulation, allCDs
 		} else if nextIdx == 2 {
 			Major_dmg = (spriest.VTSpell.Effect.DotInput.DamagePerTick() * float64(spriest.VTSpell.Effect.DotInput.NumberOfTicks)) / (gcd + nextCD).Seconds()
 		} else if nextIdx == 3 {
-			Major_dmg = (spriest.SWPSpell.Effect.DotInput.DamagePerTick() * float64(spriest.SWPSpell.Effect.DotInput.NumberOfTicks)) / (gcd + nextCD).Seconds()
+			Major_dmg = (swpSpell.Effect.DotInput.DamagePerTick() * float64(swpSpell.Effect.DotInput.NumberOfTicks)) / (gcd + nextCD).Seconds()
 		}
 
 		dpsPossibleshort := []float64{
@@ -302,7 +366,7 @@ func (spriest *ShadowPriest) IdealMindflayRotation(sim *core.Simulation, allCDs
 		mbidx:  (731.5 + spriest.GetStat(stats.SpellPower)*0.429) / (gcd + cdDiffs[mbidx]).Seconds() * averageCritMultiplier,
 		swdidx: (618 + spriest.GetStat(stats.SpellPower)*0.429) / (gcd + cdDiffs[swdidx]).Seconds() * averageCritMultiplier,
 		vtidx:  (spriest.VTSpell.Effect.DotInput.DamagePerTick() * float64(spriest.VTSpell.Effect.DotInput.NumberOfTicks)) / (gcd + cdDiffs[vtidx]).Seconds(),
-		swpidx: (spriest.SWPSpell.Effect.DotInput.DamagePerTick() * float64(spriest.SWPSpell.Effect.DotInput.NumberOfTicks)) / (gcd + cdDiffs[swpidx]).Seconds(),
+		swpidx: (swpSpell.Effect.DotInput.DamagePerTick() * float64(swpSpell.Effect.DotInput.NumberOfTicks)) / (gcd + cdDiffs[swpidx]).Seconds(),
 	}
 
 	bestIdx := 0
@@ -437,7 +501,7 @@ func (spriest *ShadowPriest) ClippingMindflayRotation(sim *core.Simulation, allC
 	}
 
 	// How many ticks we have time for.
-	numTicks := int((nextCD - time.Duration(spriest.rotation.Latency)) / tickLength)
+	numTicks := int((nextCD - spriest.Latency.ReactionTime) / tickLength)
 
 	if numTicks == 1 {
 		return 1
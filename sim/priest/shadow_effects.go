@@ -52,40 +52,45 @@ func (priest *Priest) ApplyShadowWeaving(sim *core.Simulation, target *core.Targ
 
 var ShadowWeaverAuraID = core.NewAuraID()
 
+var VampiricEmbraceActionID = core.ActionID{SpellID: 15286}
+
 func (priest *Priest) ApplyShadowOnHitEffects() {
+	// Mana and healing returns are tagged with this priest's raid index, so
+	// party members can tell which priest they came from.
+	vtManaActionID := core.ActionID{SpellID: VampiricTouchActionID.SpellID, Tag: int32(priest.RaidIndex)}
+	veActionID := core.ActionID{SpellID: VampiricEmbraceActionID.SpellID, Tag: int32(priest.RaidIndex)}
+
+	// Assumes Vampiric Embrace is kept up on every target, since refreshing
+	// it costs so little.
+	veMultiplier := 0.0
+	if priest.Talents.VampiricEmbrace {
+		veMultiplier = 0.15 + 0.05*float64(priest.Talents.ImprovedVampiricEmbrace)
+	}
+
+	onShadowDamage := func(sim *core.Simulation, spellCast *core.SpellCast, target *core.Target, damage float64) {
+		if damage <= 0 {
+			return
+		}
+
+		if priest.VTSpell.Effect.DotInput.IsTicking(sim) && priest.VTSpell.Effect.Target == target {
+			priest.giveMana(sim, damage*0.05, vtManaActionID)
+		}
+		if veMultiplier > 0 && spellCast.SpellSchool == stats.ShadowSpellPower {
+			priest.giveHealing(sim, damage*veMultiplier, veActionID)
+		}
+	}
+
 	// This is a combined aura for all priest major on hit effects.
-	//  Shadow Weaving, Vampiric Touch, and Misery
+	//  Shadow Weaving, Vampiric Touch, Vampiric Embrace, and Misery
 	priest.Character.AddPermanentAura(func(sim *core.Simulation) core.Aura {
 		return core.Aura{
 			ID: ShadowWeaverAuraID,
 			OnPeriodicDamage: func(sim *core.Simulation, spellCast *core.SpellCast, spellEffect *core.SpellEffect, tickDamage float64) {
-				if tickDamage > 0 && priest.VTSpell.Effect.DotInput.IsTicking(sim) {
-					amount := tickDamage * 0.05
-					for _, partyMember := range priest.Party.Players {
-						partyMember.GetCharacter().AddMana(sim, amount, VampiricTouchActionID, false)
-					}
-					for _, petAgent := range priest.Party.Pets {
-						pet := petAgent.GetPet()
-						if pet.IsEnabled() {
-							pet.Character.AddMana(sim, amount, VampiricTouchActionID, false)
-						}
-					}
-				}
+				onShadowDamage(sim, spellCast, spellEffect.Target, tickDamage)
 			},
 			OnSpellHit: func(sim *core.Simulation, spellCast *core.SpellCast, spellEffect *core.SpellEffect) {
 				priest.ApplyShadowWeaving(sim, spellEffect.Target)
-				if spellEffect.Damage > 0 && priest.VTSpell.Effect.DotInput.IsTicking(sim) {
-					amount := spellEffect.Damage * 0.05
-					for _, partyMember := range priest.Party.Players {
-						partyMember.GetCharacter().AddMana(sim, amount, VampiricTouchActionID, false)
-					}
-					for _, petAgent := range priest.Party.Pets {
-						pet := petAgent.GetPet()
-						if pet.IsEnabled() {
-							pet.Character.AddMana(sim, amount, VampiricTouchActionID, false)
-						}
-					}
-				}
+				onShadowDamage(sim, spellCast, spellEffect.Target, spellEffect.Damage)
 
 				if spellCast.ActionID.SpellID == SpellIDShadowWordPain || spellCast.ActionID.SpellID == VampiricTouchActionID.SpellID || spellCast.ActionID.SpellID == SpellIDMindFlay {
 					priest.ApplyMisery(sim, spellEffect.Target)
@@ -94,3 +99,31 @@ func (priest *Priest) ApplyShadowOnHitEffects() {
 		}
 	})
 }
+
+// Gives mana to each party member and pet with a mana bar.
+func (priest *Priest) giveMana(sim *core.Simulation, amount float64, actionID core.ActionID) {
+	for _, partyMember := range priest.Party.Players {
+		if character := partyMember.GetCharacter(); character.HasManaBar() {
+			character.AddMana(sim, amount, actionID, false)
+		}
+	}
+	for _, petAgent := range priest.Party.Pets {
+		pet := petAgent.GetPet()
+		if pet.IsEnabled() && pet.HasManaBar() {
+			pet.Character.AddMana(sim, amount, actionID, false)
+		}
+	}
+}
+
+// Heals each party member and pet.
+func (priest *Priest) giveHealing(sim *core.Simulation, amount float64, actionID core.ActionID) {
+	for _, partyMember := range priest.Party.Players {
+		partyMember.GetCharacter().Heal(sim, amount, actionID)
+	}
+	for _, petAgent := range priest.Party.Pets {
+		pet := petAgent.GetPet()
+		if pet.IsEnabled() {
+			pet.Character.Heal(sim, amount, actionID)
+		}
+	}
+}
@@ -57,7 +57,7 @@ func (priest *Priest) newShadowWordPainTemplate(sim *core.Simulation) core.Simpl
 
 func (priest *Priest) NewShadowWordPain(sim *core.Simulation, target *core.Target) *core.SimpleSpell {
 	// Initialize cast from precomputed template.
-	mf := &priest.SWPSpell
+	mf := priest.SWPSpellOn(target)
 
 	priest.swpCastTemplate.Apply(mf)
 
@@ -67,3 +67,8 @@ func (priest *Priest) NewShadowWordPain(sim *core.Simulation, target *core.Targe
 
 	return mf
 }
+
+// Returns the Shadow Word: Pain spell for the given target.
+func (priest *Priest) SWPSpellOn(target *core.Target) *core.SimpleSpell {
+	return &priest.SWPSpells[target.Index]
+}
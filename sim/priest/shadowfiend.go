@@ -41,7 +41,7 @@ func (priest *Priest) registerShadowfiendCD() {
 		},
 		ActivationFactory: func(sim *core.Simulation) core.CooldownActivation {
 			return func(sim *core.Simulation, character *core.Character) {
-				priest.NewShadowfiend(sim, sim.GetPrimaryTarget()).Cast(sim)
+				priest.NewShadowfiend(sim, priest.CurrentTarget(sim)).Cast(sim)
 
 				// All MCDs that use the GCD and have a non-zero cast time must call this.
 				priest.UpdateMajorCooldowns()
@@ -0,0 +1,58 @@
+package priest
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const SpellIDSmite int32 = 25364
+
+func (priest *Priest) newSmiteTemplate(sim *core.Simulation) core.SimpleSpellTemplate {
+	baseCast := core.Cast{
+		ActionID:       core.ActionID{SpellID: SpellIDSmite},
+		Character:      &priest.Character,
+		SpellSchool:    stats.HolySpellPower,
+		BaseManaCost:   385,
+		ManaCost:       385,
+		CastTime:       time.Millisecond * 2500,
+		GCD:            core.GCDDefault,
+		CritMultiplier: priest.DefaultSpellCritMultiplier(),
+	}
+
+	effect := core.SpellHitEffect{
+		SpellEffect: core.SpellEffect{
+			DamageMultiplier:       1,
+			StaticDamageMultiplier: 1,
+			ThreatMultiplier:       1,
+		},
+		DirectInput: core.DirectDamageInput{
+			MinBaseDamage:    549,
+			MaxBaseDamage:    616,
+			SpellCoefficient: 2.5 / 3.5,
+		},
+	}
+
+	priest.applyTalentsToHolySpell(&baseCast, &effect)
+
+	return core.NewSimpleSpellTemplate(core.SimpleSpell{
+		SpellCast: core.SpellCast{
+			Cast: baseCast,
+		},
+		Effect: effect,
+	})
+}
+
+func (priest *Priest) NewSmite(sim *core.Simulation, target *core.Target) *core.SimpleSpell {
+	// Initialize cast from precomputed template.
+	smite := &priest.smiteSpell
+
+	priest.smiteCastTemplate.Apply(smite)
+
+	// Set dynamic fields, i.e. the stuff we couldn't precompute.
+	smite.Effect.Target = target
+	smite.Init(sim)
+
+	return smite
+}
@@ -0,0 +1,79 @@
+package smite
+
+import (
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+var StandardTalents = &proto.PriestTalents{
+	InnerFocus:         true,
+	Meditation:         3,
+	MentalAgility:      5,
+	MentalStrength:     5,
+	DivineSpirit:       true,
+	HolySpecialization: 5,
+	DivineFury:         5,
+	SearingLight:       2,
+	SpiritualGuidance:  5,
+	SurgeOfLight:       2,
+}
+
+var PlayerOptionsStandard = &proto.Player_SmitePriest{
+	SmitePriest: &proto.SmitePriest{
+		Talents: StandardTalents,
+		Options: &proto.SmitePriest_Options{
+			UseShadowfiend: true,
+		},
+		Rotation: &proto.SmitePriest_Rotation{
+			UseHolyFire:       true,
+			UseShadowWordPain: true,
+		},
+	},
+}
+
+var FullRaidBuffs = &proto.RaidBuffs{
+	ArcaneBrilliance: true,
+	GiftOfTheWild:    proto.TristateEffect_TristateEffectImproved,
+}
+var FullIndividualBuffs = &proto.IndividualBuffs{
+	BlessingOfKings:  true,
+	BlessingOfWisdom: proto.TristateEffect_TristateEffectImproved,
+}
+
+var FullConsumes = &proto.Consumes{
+	Food:            proto.Food_FoodBlackenedBasilisk,
+	DefaultPotion:   proto.Potions_SuperManaPotion,
+	DefaultConjured: proto.Conjured_ConjuredDarkRune,
+	MainHandImbue:   proto.WeaponImbue_WeaponImbueBrilliantWizardOil,
+}
+
+var P1Gear = items.EquipmentSpecFromStrings([]items.ItemStringSpec{
+	items.ItemStringSpec{
+		Name: "Hood of Oblivion",
+		Gems: []string{
+			"Glowing Nightseye",
+			"Chaotic Skyfire Diamond",
+		},
+	},
+	items.ItemStringSpec{
+		Name: "Bracers of Havok",
+	},
+	items.ItemStringSpec{
+		Name: "Handwraps of Flowing Thought",
+	},
+	items.ItemStringSpec{
+		Name: "Belt of Divine Inspiration",
+	},
+	items.ItemStringSpec{
+		Name: "Spellstrike Pants",
+	},
+	items.ItemStringSpec{
+		Name: "Eye of Magtheridon",
+	},
+	items.ItemStringSpec{
+		Name: "Icon of the Silver Crescent",
+	},
+	items.ItemStringSpec{
+		Name: "Nathrezim Mindblade",
+	},
+})
@@ -0,0 +1,86 @@
+package smite
+
+import (
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/priest"
+)
+
+func RegisterSmitePriest() {
+	core.RegisterAgentFactory(
+		proto.Player_SmitePriest{},
+		func(character core.Character, options proto.Player) core.Agent {
+			return NewSmitePriest(character, options)
+		},
+		func(player *proto.Player, spec interface{}) {
+			playerSpec, ok := spec.(*proto.Player_SmitePriest)
+			if !ok {
+				panic("Invalid spec value for Smite Priest!")
+			}
+			player.Spec = playerSpec
+		},
+	)
+}
+
+func NewSmitePriest(character core.Character, options proto.Player) *SmitePriest {
+	smiteOptions := options.GetSmitePriest()
+	character.RejectAPLRotation("Smite Priest")
+
+	selfBuffs := priest.SelfBuffs{
+		UseShadowfiend: smiteOptions.Options.UseShadowfiend,
+	}
+	if smiteOptions.Options.PowerInfusionTarget != nil {
+		selfBuffs.PowerInfusionTarget = *smiteOptions.Options.PowerInfusionTarget
+	} else {
+		selfBuffs.PowerInfusionTarget.TargetIndex = -1
+	}
+
+	return &SmitePriest{
+		Priest:   priest.New(character, selfBuffs, *smiteOptions.Talents),
+		rotation: *smiteOptions.Rotation,
+	}
+}
+
+type SmitePriest struct {
+	*priest.Priest
+
+	rotation proto.SmitePriest_Rotation
+}
+
+func (spriest *SmitePriest) GetPriest() *priest.Priest {
+	return spriest.Priest
+}
+
+func (spriest *SmitePriest) Reset(sim *core.Simulation) {
+	spriest.Priest.Reset(sim)
+}
+
+func (spriest *SmitePriest) OnGCDReady(sim *core.Simulation) {
+	spriest.tryUseGCD(sim)
+}
+
+func (spriest *SmitePriest) OnManaTick(sim *core.Simulation) {
+	if spriest.FinishedWaitingForManaAndGCDReady(sim) {
+		spriest.tryUseGCD(sim)
+	}
+}
+
+func (spriest *SmitePriest) tryUseGCD(sim *core.Simulation) {
+	target := spriest.CurrentTarget(sim)
+
+	var spell *core.SimpleSpell
+	if spriest.HasReactedToAura(sim, priest.SurgeOfLightProcAuraID) {
+		// Free, instant Smite, once the priest has noticed the proc.
+		spell = spriest.NewSmite(sim, target)
+	} else if spriest.rotation.UseShadowWordPain && !spriest.SWPSpellOn(target).IsInUse() {
+		spell = spriest.NewShadowWordPain(sim, target)
+	} else if spriest.rotation.UseHolyFire && !spriest.HolyFireSpell.IsInUse() && !spriest.IsOnCD(priest.HolyFireCooldownID, sim.CurrentTime) {
+		spell = spriest.NewHolyFire(sim, target)
+	} else {
+		spell = spriest.NewSmite(sim, target)
+	}
+
+	if success := spell.Cast(sim); !success {
+		spriest.WaitForMana(sim, spell.GetManaCost())
+	}
+}
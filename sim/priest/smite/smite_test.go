@@ -0,0 +1,37 @@
+package smite
+
+import (
+	"testing"
+
+	_ "github.com/wowsims/tbc/sim/common"
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func init() {
+	RegisterSmitePriest()
+}
+
+func TestSmite(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator(core.CharacterSuiteConfig{
+		Class: proto.Class_ClassPriest,
+
+		Race: proto.Race_RaceHuman,
+
+		GearSet: core.GearSetCombo{Label: "P1", GearSet: P1Gear},
+
+		SpecOptions: core.SpecOptionsCombo{Label: "Standard", SpecOptions: PlayerOptionsStandard},
+
+		RaidBuffs:   FullRaidBuffs,
+		PlayerBuffs: FullIndividualBuffs,
+		Consumes:    FullConsumes,
+		Debuffs:     &proto.Debuffs{},
+
+		ItemFilter: core.ItemFilter{
+			ArmorType: proto.ArmorType_ArmorTypeCloth,
+			RangedWeaponTypes: []proto.RangedWeaponType{
+				proto.RangedWeaponType_RangedWeaponTypeWand,
+			},
+		},
+	}))
+}
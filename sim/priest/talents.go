@@ -4,12 +4,33 @@ import (
 	"time"
 
 	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
 )
 
 func (priest *Priest) applyTalents() {
 	if priest.Talents.Meditation > 0 {
 		priest.PseudoStats.SpiritRegenRateCasting = float64(priest.Talents.Meditation) * 0.1
 	}
+
+	if priest.Talents.SpiritualGuidance > 0 {
+		bonus := 0.05 * float64(priest.Talents.SpiritualGuidance)
+		priest.AddStatDependency(stats.StatDependency{
+			SourceStat:   stats.Spirit,
+			ModifiedStat: stats.SpellPower,
+			Modifier: func(spirit float64, spellPower float64) float64 {
+				return spellPower + spirit*bonus
+			},
+		})
+		priest.AddStatDependency(stats.StatDependency{
+			SourceStat:   stats.Spirit,
+			ModifiedStat: stats.HealingPower,
+			Modifier: func(spirit float64, healingPower float64) float64 {
+				return healingPower + spirit*bonus
+			},
+		})
+	}
+
+	priest.applySurgeOfLight()
 }
 
 var InnerFocusAuraID = core.NewAuraID()
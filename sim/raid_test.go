@@ -6,9 +6,13 @@ import (
 	"github.com/wowsims/tbc/sim/core"
 	"github.com/wowsims/tbc/sim/core/proto"
 
+	"github.com/wowsims/tbc/sim/core/warrior"
 	balanceDruid "github.com/wowsims/tbc/sim/druid/balance"
+	"github.com/wowsims/tbc/sim/hunter"
 	shadowPriest "github.com/wowsims/tbc/sim/priest/shadow"
 	elementalShaman "github.com/wowsims/tbc/sim/shaman/elemental"
+	restoShaman "github.com/wowsims/tbc/sim/shaman/restoration"
+	googleProto "google.golang.org/protobuf/proto"
 )
 
 func init() {
@@ -112,5 +116,517 @@ func TestBasicRaid(t *testing.T) {
 		SimOptions: SimOptions,
 	}
 
-	core.RaidSimTest("P1 ST", t, rsr, 4096.15)
+	core.RaidSimTest("P1 ST", t, rsr, 4094.59)
+}
+
+func TestVampiricTouchMana(t *testing.T) {
+	result := core.RunRaidSim(&proto.RaidSimRequest{
+		Raid:       BasicRaid,
+		Encounter:  STEncounter,
+		SimOptions: SimOptions,
+	})
+
+	// The shadow priest is at raid index 2.
+	druidMetrics := result.RaidMetrics.Parties[0].Players[0]
+	vtMana := 0.0
+	for _, manaGained := range druidMetrics.ManaGained {
+		if manaGained.Id.GetSpellId() == 34917 && manaGained.Id.Tag == 2 {
+			vtMana += manaGained.ManaGainedAvg + manaGained.OverflowAvg
+		}
+	}
+	if vtMana <= 0 {
+		t.Fatalf("Expected the druid to gain mana from the shadow priest's Vampiric Touch, got %v", druidMetrics.ManaGained)
+	}
+}
+
+func TestManaTideTotem(t *testing.T) {
+	restoShamanPlayer := &proto.Player{
+		Name:      "P1 Resto Shaman",
+		Race:      proto.Race_RaceDraenei,
+		Class:     proto.Class_ClassShaman,
+		Equipment: restoShaman.P1Gear,
+		Spec:      restoShaman.PlayerOptionsBasic,
+	}
+
+	// Mana from Mana Tide Totem gained by the druid, by action tag.
+	manaTideMana := func(useManaTide bool) map[int32]float64 {
+		player := googleProto.Clone(restoShamanPlayer).(*proto.Player)
+		player.GetRestorationShaman().Rotation.Totems.UseManaTide = useManaTide
+
+		result := core.RunRaidSim(&proto.RaidSimRequest{
+			Raid: &proto.Raid{
+				Parties: []*proto.Party{
+					{
+						Players: []*proto.Player{P1BalanceDruid, player},
+						Buffs:   &proto.PartyBuffs{ManaTideTotems: 1},
+					},
+				},
+			},
+			Encounter:  STEncounter,
+			SimOptions: SimOptions,
+		})
+
+		manaByTag := map[int32]float64{}
+		for _, manaGained := range result.RaidMetrics.Parties[0].Players[0].ManaGained {
+			if manaGained.Id.GetSpellId() == 16190 {
+				manaByTag[manaGained.Id.Tag] += manaGained.ManaGainedAvg + manaGained.OverflowAvg
+			}
+		}
+		return manaByTag
+	}
+
+	// The shaman is at raid index 1, and replaces the estimated Mana Tide.
+	if mana := manaTideMana(true); mana[1] <= 0 || mana[-1] != 0 {
+		t.Fatalf("Expected Mana Tide only from the resto shaman, got %v", mana)
+	}
+	if mana := manaTideMana(false); mana[1] != 0 || mana[-1] <= 0 {
+		t.Fatalf("Expected only the estimated Mana Tide when the shaman doesn't use it, got %v", mana)
+	}
+}
+
+func TestAPLRotation(t *testing.T) {
+	flameShockID := &proto.ActionID{RawId: &proto.ActionID_SpellId{SpellId: 25457}}
+	lightningBoltID := &proto.ActionID{RawId: &proto.ActionID_SpellId{SpellId: 25449}}
+
+	result := core.RunRaidSim(&proto.RaidSimRequest{
+		Raid: core.SinglePlayerRaidProto(&proto.Player{
+			Name:      "APL Ele Shaman",
+			Race:      proto.Race_RaceOrc,
+			Class:     proto.Class_ClassShaman,
+			Equipment: elementalShaman.P1Gear,
+			Consumes:  elementalShaman.FullConsumes,
+			Spec:      elementalShaman.PlayerOptionsAdaptive,
+			Buffs:     elementalShaman.FullIndividualBuffs,
+			AplRotation: &proto.APLRotation{
+				Actions: []*proto.APLAction{
+					&proto.APLAction{
+						Id: flameShockID,
+						Conditions: []*proto.APLCondition{
+							&proto.APLCondition{
+								Value:      proto.APLCondition_DotRemainingTime,
+								Id:         flameShockID,
+								Comparison: proto.APLCondition_LessThanOrEqual,
+								Threshold:  0,
+							},
+						},
+					},
+					&proto.APLAction{
+						Id: lightningBoltID,
+					},
+				},
+			},
+		}, nil, nil),
+		Encounter:  STEncounter,
+		SimOptions: SimOptions,
+	})
+
+	playerMetrics := result.RaidMetrics.Parties[0].Players[0]
+	if playerMetrics.Dps.Avg <= 0 {
+		t.Fatalf("Expected positive DPS from the APL rotation, got %0.02f", playerMetrics.Dps.Avg)
+	}
+
+	flameShockCasts := int32(0)
+	for _, action := range playerMetrics.Actions {
+		switch action.Id.GetSpellId() {
+		case 25457:
+			flameShockCasts += action.Casts
+		case 25442:
+			t.Fatalf("Chain Lightning isn't in the APL, but was cast %d times", action.Casts)
+		}
+	}
+	if flameShockCasts == 0 {
+		t.Fatalf("Expected the APL rotation to cast Flame Shock")
+	}
+}
+
+func TestHunterAPLRotation(t *testing.T) {
+	autoShotID := &proto.ActionID{RawId: &proto.ActionID_OtherId{OtherId: proto.OtherAction_OtherActionShoot}}
+	steadyShotID := &proto.ActionID{RawId: &proto.ActionID_SpellId{SpellId: 34120}}
+
+	result := core.RunRaidSim(&proto.RaidSimRequest{
+		Raid: core.SinglePlayerRaidProto(&proto.Player{
+			Name:      "APL Hunter",
+			Race:      proto.Race_RaceOrc,
+			Class:     proto.Class_ClassHunter,
+			Equipment: hunter.P1Gear,
+			Consumes:  hunter.FullConsumes,
+			Spec:      hunter.PlayerOptionsBasic,
+			Buffs:     hunter.FullIndividualBuffs,
+			AplRotation: &proto.APLRotation{
+				Actions: []*proto.APLAction{
+					&proto.APLAction{
+						Id: autoShotID,
+					},
+					&proto.APLAction{
+						Id: steadyShotID,
+					},
+				},
+			},
+		}, nil, nil),
+		Encounter:  STEncounter,
+		SimOptions: SimOptions,
+	})
+
+	playerMetrics := result.RaidMetrics.Parties[0].Players[0]
+	if playerMetrics.Dps.Avg <= 0 {
+		t.Fatalf("Expected positive DPS from the APL rotation, got %0.02f", playerMetrics.Dps.Avg)
+	}
+
+	steadyShotCasts := int32(0)
+	for _, action := range playerMetrics.Actions {
+		switch action.Id.GetSpellId() {
+		case 34120:
+			steadyShotCasts += action.Casts
+		case 27021:
+			t.Fatalf("Multi-Shot isn't in the APL, but was cast %d times", action.Casts)
+		}
+	}
+	if steadyShotCasts == 0 {
+		t.Fatalf("Expected the APL rotation to cast Steady Shot")
+	}
+}
+
+func TestAPLRotationErrors(t *testing.T) {
+	lightningBoltID := &proto.ActionID{RawId: &proto.ActionID_SpellId{SpellId: 25449}}
+	unknownID := &proto.ActionID{RawId: &proto.ActionID_SpellId{SpellId: 12345}}
+
+	newRequest := func(player *proto.Player, actions ...*proto.APLAction) *proto.RaidSimRequest {
+		player = googleProto.Clone(player).(*proto.Player)
+		player.AplRotation = &proto.APLRotation{Actions: actions}
+		return &proto.RaidSimRequest{
+			Raid:       core.SinglePlayerRaidProto(player, nil, nil),
+			Encounter:  STEncounter,
+			SimOptions: SimOptions,
+		}
+	}
+	withCondition := func(condition *proto.APLCondition) *proto.RaidSimRequest {
+		return newRequest(P1ElementalShaman, &proto.APLAction{
+			Id:         lightningBoltID,
+			Conditions: []*proto.APLCondition{condition},
+		})
+	}
+
+	for name, request := range map[string]*proto.RaidSimRequest{
+		"unsupported spec": newRequest(&proto.Player{
+			Name:      "APL Warrior",
+			Race:      proto.Race_RaceOrc,
+			Class:     proto.Class_ClassWarrior,
+			Equipment: hunter.P1Gear,
+			Spec:      warrior.PlayerOptionsBasic,
+		}),
+		"missing action id": newRequest(P1ElementalShaman, &proto.APLAction{}),
+		"unknown action":    newRequest(P1ElementalShaman, &proto.APLAction{Id: unknownID}),
+		"missing comparison": withCondition(&proto.APLCondition{
+			Value: proto.APLCondition_CurrentTime,
+		}),
+		"unknown dot": withCondition(&proto.APLCondition{
+			Value:      proto.APLCondition_DotRemainingTime,
+			Id:         lightningBoltID,
+			Comparison: proto.APLCondition_LessThan,
+		}),
+		"unknown cooldown": withCondition(&proto.APLCondition{
+			Value:      proto.APLCondition_CooldownRemaining,
+			Id:         unknownID,
+			Comparison: proto.APLCondition_LessThan,
+		}),
+		"unknown value": withCondition(&proto.APLCondition{
+			Value:      proto.APLCondition_ValueUnknown,
+			Comparison: proto.APLCondition_LessThan,
+		}),
+	} {
+		if result := core.RunRaidSim(request); result.ErrorResult == "" {
+			t.Fatalf("Expected an error for an APL with a %s", name)
+		}
+	}
+}
+
+func TestPartyOptimizer(t *testing.T) {
+	request := &proto.PartyOptimizerRequest{
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{
+				&proto.Party{
+					Players: []*proto.Player{
+						P1BalanceDruid,
+						P1ShadowPriest,
+					},
+				},
+				&proto.Party{
+					Players: []*proto.Player{
+						P1ElementalShaman,
+					},
+				},
+			},
+		},
+		Encounter:          STEncounter,
+		SimOptions:         SimOptions,
+		FixedRaidIndices:   []int32{5},
+		BuffBotRaidIndices: []int32{2},
+		MaxPartySize:       3,
+	}
+
+	result := core.OptimizeParties(request)
+	if result.Dps < result.InputDps || result.DpsGain != result.Dps-result.InputDps {
+		t.Fatalf("Expected the best layout to be at least as good as the input, got %0.2f vs %0.2f", result.Dps, result.InputDps)
+	}
+
+	raidIndices := map[string]int32{}
+	for partyIdx, party := range result.Raid.Parties {
+		numPlayers := 0
+		for playerIdx, player := range party.Players {
+			if player.Class == proto.Class_ClassUnknown {
+				continue
+			}
+			numPlayers++
+			raidIndices[player.Name] = int32(partyIdx*5 + playerIdx)
+		}
+		if numPlayers > 3 || (partyIdx == 0 && numPlayers > 2) {
+			t.Fatalf("Party %d has too many players: %d", partyIdx, numPlayers)
+		}
+	}
+
+	if len(raidIndices) != 3 {
+		t.Fatalf("Expected all 3 players in the result, got %v", raidIndices)
+	}
+	if raidIndices[P1ElementalShaman.Name] != 5 {
+		t.Fatalf("Fixed player was moved to %d", raidIndices[P1ElementalShaman.Name])
+	}
+	for name, raidIndex := range raidIndices {
+		if raidIndex == 2 {
+			t.Fatalf("%s was placed in the buff bot slot", name)
+		}
+	}
+
+	druidIndex := raidIndices[P1BalanceDruid.Name]
+	druid := result.Raid.Parties[druidIndex/5].Players[druidIndex%5]
+	if innervateTarget := druid.GetBalanceDruid().Options.InnervateTarget.TargetIndex; innervateTarget != druidIndex {
+		t.Fatalf("Expected self innervate to follow the druid to %d, got %d", druidIndex, innervateTarget)
+	}
+}
+
+func TestPartyOptimizerErrors(t *testing.T) {
+	raid := core.SinglePlayerRaidProto(P1BalanceDruid, nil, nil)
+
+	result := core.OptimizeParties(&proto.PartyOptimizerRequest{
+		Raid:            raid,
+		Encounter:       STEncounter,
+		SimOptions:      SimOptions,
+		PresetEncounter: "Not a real encounter",
+	})
+	if result.ErrorResult == "" {
+		t.Fatalf("Expected an error for an unknown preset encounter")
+	}
+
+	result = core.OptimizeParties(&proto.PartyOptimizerRequest{
+		Raid:               raid,
+		Encounter:          STEncounter,
+		SimOptions:         SimOptions,
+		BuffBotRaidIndices: []int32{25},
+	})
+	if result.ErrorResult == "" {
+		t.Fatalf("Expected an error for an invalid buff bot raid index")
+	}
+
+	result = core.OptimizeParties(&proto.PartyOptimizerRequest{
+		Raid:               raid,
+		Encounter:          STEncounter,
+		SimOptions:         SimOptions,
+		BuffBotRaidIndices: []int32{0},
+	})
+	if result.ErrorResult == "" {
+		t.Fatalf("Expected an error for a buff bot in a player's slot")
+	}
+}
+
+func TestSolveBlessings(t *testing.T) {
+	request := &proto.RaidSimRequest{
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{
+				&proto.Party{
+					Players: []*proto.Player{
+						P1BalanceDruid,
+						P1ShadowPriest,
+						P1ElementalShaman,
+					},
+				},
+			},
+		},
+		Encounter:  STEncounter,
+		SimOptions: SimOptions,
+	}
+	paladins := []*proto.PaladinTalents{
+		&proto.PaladinTalents{ImprovedBlessingOfWisdom: 2},
+		&proto.PaladinTalents{BlessingOfKings: true},
+	}
+
+	solution := core.SolveBlessings(request, paladins, []int32{1})
+	if solution.Dps < solution.BaselineDps {
+		t.Fatalf("Expected blessings to not lower DPS, got %0.2f vs %0.2f", solution.Dps, solution.BaselineDps)
+	}
+
+	if len(solution.Assignments.Paladins) != 2 {
+		t.Fatalf("Expected assignments for 2 paladins, got %d", len(solution.Assignments.Paladins))
+	}
+	for _, spec := range []proto.Spec{proto.Spec_SpecBalanceDruid, proto.Spec_SpecShadowPriest, proto.Spec_SpecElementalShaman} {
+		first := solution.Assignments.Paladins[0].Blessings[spec]
+		second := solution.Assignments.Paladins[1].Blessings[spec]
+		if first == second {
+			t.Fatalf("Spec %s was given %s twice", spec, first)
+		}
+		if first == proto.Blessings_BlessingOfKings {
+			t.Fatalf("Spec %s was given Kings by a paladin without the talent", spec)
+		}
+	}
+
+	if !solution.PlayerBuffs[1].BlessingOfSalvation {
+		t.Fatalf("Expected threat-limited player to get Salvation")
+	}
+	if solution.PlayerBuffs[0].BlessingOfSalvation {
+		t.Fatalf("Only threat-limited specs should get Salvation")
+	}
+	if solution.PlayerBuffs[3] != nil {
+		t.Fatalf("Expected no buffs for an empty slot")
+	}
+}
+
+func TestRotationOptimizer(t *testing.T) {
+	request := &proto.RotationOptimizerRequest{
+		RaidSimRequest: &proto.RaidSimRequest{
+			Raid: core.SinglePlayerRaidProto(&proto.Player{
+				Name:      "Fixed LB/CL Ele Shaman",
+				Race:      proto.Race_RaceOrc,
+				Class:     proto.Class_ClassShaman,
+				Equipment: elementalShaman.P1Gear,
+				Consumes:  elementalShaman.FullConsumes,
+				Spec:      elementalShaman.PlayerOptionsFixed3LBCL,
+				Buffs:     elementalShaman.FullIndividualBuffs,
+			}, nil, nil),
+			Encounter:  STEncounter,
+			SimOptions: SimOptions,
+		},
+		Parameters: []*proto.RotationParameter{
+			&proto.RotationParameter{
+				RaidIndex: 0,
+				Path:      "elemental_shaman.rotation.lbs_per_cl",
+				MinValue:  0,
+				MaxValue:  3,
+				Step:      1,
+			},
+		},
+	}
+
+	result := core.OptimizeRotation(request)
+	if result.Dps < result.InputDps {
+		t.Fatalf("Best DPS should be at least the input DPS, got %0.2f vs %0.2f", result.Dps, result.InputDps)
+	}
+
+	// The input value is on the grid, so it shouldn't be simmed twice.
+	if result.NumSims != 4 || len(result.Samples) != 4 {
+		t.Fatalf("Expected 4 sims, got %d sims and %d samples", result.NumSims, len(result.Samples))
+	}
+
+	lbsPerCl := result.Raid.Parties[0].Players[0].GetElementalShaman().Rotation.LbsPerCl
+	if float64(lbsPerCl) != result.BestValues[0] {
+		t.Fatalf("Expected the result raid to use the best value %0.0f, got %d", result.BestValues[0], lbsPerCl)
+	}
+	if elementalShaman.PlayerOptionsFixed3LBCL.ElementalShaman.Rotation.LbsPerCl != 3 {
+		t.Fatalf("Input request should not be modified")
+	}
+}
+
+func TestRotationOptimizerErrors(t *testing.T) {
+	newRequest := func(parameter *proto.RotationParameter) *proto.RotationOptimizerRequest {
+		return &proto.RotationOptimizerRequest{
+			RaidSimRequest: &proto.RaidSimRequest{
+				Raid:       core.SinglePlayerRaidProto(P1ElementalShaman, nil, nil),
+				Encounter:  STEncounter,
+				SimOptions: SimOptions,
+			},
+			Parameters: []*proto.RotationParameter{parameter},
+		}
+	}
+	validParameter := &proto.RotationParameter{
+		Path:     "elemental_shaman.rotation.lbs_per_cl",
+		MaxValue: 3,
+		Step:     1,
+	}
+
+	badPreset := newRequest(validParameter)
+	badPreset.RaidSimRequest.PresetEncounter = "Not a real encounter"
+
+	for name, request := range map[string]*proto.RotationOptimizerRequest{
+		"bad preset":  badPreset,
+		"bad path":    newRequest(&proto.RotationParameter{Path: "elemental_shaman.rotation.not_a_field", MaxValue: 3, Step: 1}),
+		"bad step":    newRequest(&proto.RotationParameter{Path: validParameter.Path, MaxValue: 3}),
+		"bad range":   newRequest(&proto.RotationParameter{Path: validParameter.Path, MinValue: 3, Step: 1}),
+		"bad player":  newRequest(&proto.RotationParameter{RaidIndex: 7, Path: validParameter.Path, MaxValue: 3, Step: 1}),
+		"wrong spec":  newRequest(&proto.RotationParameter{Path: "mage.rotation.type", MaxValue: 3, Step: 1}),
+		"not numeric": newRequest(&proto.RotationParameter{Path: "elemental_shaman.rotation", MaxValue: 3, Step: 1}),
+	} {
+		if result := core.OptimizeRotation(request); result.ErrorResult == "" {
+			t.Fatalf("Expected an error for a %s", name)
+		}
+	}
+}
+
+func TestCooldownOptimizer(t *testing.T) {
+	request := &proto.CooldownOptimizerRequest{
+		RaidSimRequest: &proto.RaidSimRequest{
+			Raid:       core.SinglePlayerRaidProto(P1ElementalShaman, nil, nil),
+			Encounter:  STEncounter,
+			SimOptions: SimOptions,
+		},
+		TimeStep:  60,
+		MaxPasses: 1,
+	}
+
+	result := core.OptimizeCooldowns(request)
+	if result.Dps < result.InputDps || result.Dps < result.AsapDps {
+		t.Fatalf("Optimized DPS should be at least the input and ASAP DPS, got %0.2f vs %0.2f and %0.2f", result.Dps, result.InputDps, result.AsapDps)
+	}
+	if result.DpsGain != result.Dps-result.InputDps || result.AsapDpsGain != result.Dps-result.AsapDps {
+		t.Fatalf("DPS gains don't match DPS values")
+	}
+
+	if len(result.Players) != 1 || result.Players[0].RaidIndex != 0 {
+		t.Fatalf("Expected cooldowns for the player at raid index 0, got %v", result.Players)
+	}
+	for _, cooldown := range result.Players[0].Cooldowns.GetCooldowns() {
+		for _, timing := range cooldown.Timings {
+			if timing < 0 || timing >= STEncounter.Duration {
+				t.Fatalf("Timing %0.1f for %v is outside the encounter", timing, cooldown.Id)
+			}
+		}
+	}
+	if P1ElementalShaman.Cooldowns != nil {
+		t.Fatalf("Input request should not be modified")
+	}
+}
+
+func TestCooldownOptimizerErrors(t *testing.T) {
+	newRequest := func() *proto.CooldownOptimizerRequest {
+		return &proto.CooldownOptimizerRequest{
+			RaidSimRequest: &proto.RaidSimRequest{
+				Raid:       core.SinglePlayerRaidProto(P1ElementalShaman, nil, nil),
+				Encounter:  STEncounter,
+				SimOptions: SimOptions,
+			},
+			TimeStep:  60,
+			MaxPasses: 1,
+		}
+	}
+
+	badPreset := newRequest()
+	badPreset.RaidSimRequest.PresetEncounter = "Not a real encounter"
+
+	noRaid := newRequest()
+	noRaid.RaidSimRequest.Raid = nil
+
+	for name, request := range map[string]*proto.CooldownOptimizerRequest{
+		"bad preset": badPreset,
+		"no raid":    noRaid,
+	} {
+		if result := core.OptimizeCooldowns(request); result.ErrorResult == "" {
+			t.Fatalf("Expected an error for a %s", name)
+		}
+	}
 }
@@ -4,12 +4,17 @@ import (
 	_ "github.com/wowsims/tbc/sim/common"
 	"github.com/wowsims/tbc/sim/core/warrior"
 	"github.com/wowsims/tbc/sim/druid/balance"
+	restoDruid "github.com/wowsims/tbc/sim/druid/restoration"
 	"github.com/wowsims/tbc/sim/hunter"
 	"github.com/wowsims/tbc/sim/mage"
+	holyPaladin "github.com/wowsims/tbc/sim/paladin/holy"
 	"github.com/wowsims/tbc/sim/paladin/retribution"
+	holyPriest "github.com/wowsims/tbc/sim/priest/holy"
 	"github.com/wowsims/tbc/sim/priest/shadow"
+	"github.com/wowsims/tbc/sim/priest/smite"
 	"github.com/wowsims/tbc/sim/shaman/elemental"
 	"github.com/wowsims/tbc/sim/shaman/enhancement"
+	restoShaman "github.com/wowsims/tbc/sim/shaman/restoration"
 )
 
 var registered = false
@@ -21,11 +26,16 @@ func RegisterAll() {
 	registered = true
 
 	balance.RegisterBalanceDruid()
+	restoDruid.RegisterRestorationDruid()
 	elemental.RegisterElementalShaman()
 	enhancement.RegisterEnhancementShaman()
+	restoShaman.RegisterRestorationShaman()
 	hunter.RegisterHunter()
 	mage.RegisterMage()
 	shadow.RegisterShadowPriest()
+	holyPriest.RegisterHolyPriest()
+	smite.RegisterSmitePriest()
 	warrior.RegisterWarrior()
 	retribution.RegisterRetributionPaladin()
+	holyPaladin.RegisterHolyPaladin()
 }
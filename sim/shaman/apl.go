@@ -0,0 +1,61 @@
+package shaman
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+)
+
+// Registers the actions which APL rotations can use.
+func (shaman *Shaman) registerAPLActions() {
+	shaman.RegisterAPLAction(core.APLActionConfig{
+		ActionID: core.ActionID{SpellID: SpellIDLB12},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return shaman.NewLightningBolt(sim, target, false).Cast(sim)
+		},
+	})
+	shaman.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   core.ActionID{SpellID: SpellIDCL6},
+		CooldownID: ChainLightningCooldownID,
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return shaman.NewChainLightning(sim, target, false).Cast(sim)
+		},
+	})
+	shaman.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   core.ActionID{SpellID: SpellIDEarthShock},
+		CooldownID: ShockCooldownID,
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return shaman.NewEarthShock(sim, target).Cast(sim)
+		},
+	})
+	shaman.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   core.ActionID{SpellID: SpellIDFlameShock},
+		CooldownID: ShockCooldownID,
+		DotRemaining: func(sim *core.Simulation, target *core.Target) time.Duration {
+			if !shaman.FlameShockSpell.IsInUse() || shaman.FlameShockSpell.Effect.Target != target {
+				return 0
+			}
+			return shaman.FlameShockSpell.Effect.DotInput.TimeRemaining(sim)
+		},
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return shaman.NewFlameShock(sim, target).Cast(sim)
+		},
+	})
+	shaman.RegisterAPLAction(core.APLActionConfig{
+		ActionID:   core.ActionID{SpellID: SpellIDFrostShock},
+		CooldownID: ShockCooldownID,
+		Execute: func(sim *core.Simulation, target *core.Target) bool {
+			return shaman.NewFrostShock(sim, target).Cast(sim)
+		},
+	})
+
+	if shaman.Talents.Stormstrike {
+		shaman.RegisterAPLAction(core.APLActionConfig{
+			ActionID:   core.ActionID{SpellID: StormstrikeActionID.SpellID},
+			CooldownID: StormstrikeCD,
+			Execute: func(sim *core.Simulation, target *core.Target) bool {
+				return shaman.NewStormstrike(sim, target).Attack(sim)
+			},
+		})
+	}
+}
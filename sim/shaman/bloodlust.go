@@ -11,7 +11,7 @@ func (shaman *Shaman) registerBloodlustCD() {
 		return
 	}
 	actionID := core.ActionID{
-		SpellID:    2825,
+		SpellID:    core.SpellIDBloodlust,
 		CooldownID: BloodlustCooldownID,
 		Tag:        int32(shaman.RaidIndex),
 	}
@@ -0,0 +1,75 @@
+package shaman
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const SpellIDChainHeal5 int32 = 25423
+
+// Helper for precomputing heal effects, with the talents shared by all shaman heals.
+func (shaman *Shaman) newHealEffect(minBaseHealing float64, maxBaseHealing float64, spellCoefficient float64) core.HealEffect {
+	effect := core.HealEffect{
+		HealingMultiplier: 1,
+		DirectInput: core.DirectHealInput{
+			MinBaseHealing:   minBaseHealing,
+			MaxBaseHealing:   maxBaseHealing,
+			SpellCoefficient: spellCoefficient,
+		},
+	}
+
+	effect.HealingMultiplier *= 1 + 0.02*float64(shaman.Talents.Purification)
+	effect.BonusCritRating += float64(shaman.Talents.TidalMastery) * 1 * core.SpellCritRatingPerCritChance
+
+	return effect
+}
+
+// newChainHealTemplate returns a cast generator for Chain Heal with as many fields precomputed as possible.
+func (shaman *Shaman) newChainHealTemplate(sim *core.Simulation) core.SimpleHealTemplate {
+	baseManaCost := 540.0
+	healTemplate := core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: core.Cast{
+				ActionID:       core.ActionID{SpellID: SpellIDChainHeal5},
+				Character:      shaman.GetCharacter(),
+				SpellSchool:    stats.NatureSpellPower,
+				BaseManaCost:   baseManaCost,
+				ManaCost:       baseManaCost,
+				CastTime:       time.Millisecond * 2500,
+				GCD:            core.GCDDefault,
+				CritMultiplier: core.HealCritMultiplier,
+			},
+		},
+	}
+
+	effect := shaman.newHealEffect(826, 943, 2.5/3.5)
+	effect.HealingMultiplier *= 1 + 0.1*float64(shaman.Talents.ImprovedChainHeal)
+
+	// Chain Heal jumps to 2 more targets, healing 50% less with each jump.
+	effects := make([]core.HealEffect, 0, 3)
+	effects = append(effects, effect)
+	for i := 1; i < 3; i++ {
+		bounceEffect := effects[i-1] // Makes a copy of the previous bounce
+		bounceEffect.HealingMultiplier *= 0.5
+		effects = append(effects, bounceEffect)
+	}
+	healTemplate.Effects = effects
+
+	return core.NewSimpleHealTemplate(healTemplate)
+}
+
+// Casts Chain Heal on the target, which then jumps to the most injured players.
+func (shaman *Shaman) NewChainHeal(sim *core.Simulation, target *core.Character) *core.SimpleHeal {
+	// Initialize cast from precomputed template.
+	ch := &shaman.chainHealSpell
+	shaman.chainHealCastTemplate.Apply(ch)
+
+	// Set dynamic fields, i.e. the stuff we couldn't precompute.
+	ch.Effects[0].Target = target
+
+	ch.Init(sim)
+
+	return ch
+}
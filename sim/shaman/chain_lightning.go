@@ -92,16 +92,18 @@ func (shaman *Shaman) NewChainLightning(sim *core.Simulation, target *core.Targe
 
 	// Set dynamic fields, i.e. the stuff we couldn't precompute.
 
-	// Set the targets.
+	// Set the targets, picking bounce targets by index, incrementally, and
+	// skipping targets which can't currently be attacked.
 	cl.Effects[0].Target = target
-	curTargetIndex := target.Index
-	numHits := core.MinInt32(3, sim.GetNumTargets())
-	for i := int32(1); i < numHits; i++ {
-		// Pick targets by index, incrementally.
-		newTargetIndex := (curTargetIndex + 1) % sim.GetNumTargets()
-		cl.Effects[i].Target = sim.GetTarget(newTargetIndex)
-		curTargetIndex = newTargetIndex
+	numHits := 1
+	for i := int32(1); i < sim.GetNumTargets() && numHits < len(cl.Effects); i++ {
+		bounceTarget := sim.GetTarget((target.Index + i) % sim.GetNumTargets())
+		if bounceTarget.IsActive() {
+			cl.Effects[numHits].Target = bounceTarget
+			numHits++
+		}
 	}
+	cl.Effects = cl.Effects[:numHits]
 
 	shaman.applyElectricSpellCastInitModifiers(&cl.SpellCast)
 
@@ -0,0 +1,107 @@
+package shaman
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+const SpellIDEarthShield3 int32 = 32594
+
+// Earth Shield is a buff on another player, so all shamans share this ID, the
+// same way only 1 Earth Shield can be on a player in-game.
+var EarthShieldAuraID = core.NewAuraID()
+
+const earthShieldCharges = 6
+const earthShieldDuration = time.Minute * 10
+
+// Earth Shield can only heal once every few seconds.
+const earthShieldHealCooldown = time.Second * 3
+
+func (shaman *Shaman) newEarthShieldTemplate(sim *core.Simulation) core.SimpleCast {
+	baseManaCost := 600.0
+	return core.SimpleCast{
+		Cast: core.Cast{
+			ActionID:     core.ActionID{SpellID: SpellIDEarthShield3},
+			Character:    shaman.GetCharacter(),
+			SpellSchool:  stats.NatureSpellPower,
+			BaseManaCost: baseManaCost,
+			ManaCost:     baseManaCost,
+			GCD:          core.GCDDefault,
+		},
+	}
+}
+
+// The heal from each charge of Earth Shield. These use a separate tag so they
+// show up separately from the casts in metrics.
+func (shaman *Shaman) newEarthShieldHealTemplate(sim *core.Simulation) core.SimpleHealTemplate {
+	effect := shaman.newHealEffect(270, 270, 0.286)
+	effect.IgnoreCritCheck = true
+
+	return core.NewSimpleHealTemplate(core.SimpleHeal{
+		SpellCast: core.SpellCast{
+			Cast: core.Cast{
+				ActionID:       core.ActionID{SpellID: SpellIDEarthShield3, Tag: 1},
+				Character:      shaman.GetCharacter(),
+				SpellSchool:    stats.NatureSpellPower,
+				IgnoreManaCost: true,
+				IsPhantom:      true,
+				CritMultiplier: core.HealCritMultiplier,
+			},
+		},
+		Effect: effect,
+	})
+}
+
+// Casts Earth Shield on the target, replacing any existing Earth Shield.
+func (shaman *Shaman) NewEarthShield(sim *core.Simulation, target *core.Character) *core.SimpleCast {
+	shaman.earthShieldSpell = shaman.earthShieldCastTemplate
+	shaman.earthShieldSpell.OnCastComplete = func(sim *core.Simulation, cast *core.Cast) {
+		target.AddAura(sim, shaman.earthShieldAura(sim, target, earthShieldCharges, 0))
+	}
+	shaman.earthShieldSpell.Init(sim)
+	return &shaman.earthShieldSpell
+}
+
+func (shaman *Shaman) earthShieldAura(sim *core.Simulation, target *core.Character, charges int32, nextHealAt time.Duration) core.Aura {
+	expires := sim.CurrentTime + earthShieldDuration
+	if target.HasAura(EarthShieldAuraID) {
+		expires = sim.CurrentTime + target.RemainingAuraDuration(sim, EarthShieldAuraID)
+	}
+
+	consumed := false
+	return core.Aura{
+		ID:       EarthShieldAuraID,
+		ActionID: core.ActionID{SpellID: SpellIDEarthShield3},
+		Expires:  expires,
+		Stacks:   charges,
+		OnDamageTaken: func(sim *core.Simulation, character *core.Character, damage float64) {
+			if consumed || sim.CurrentTime < nextHealAt {
+				return
+			}
+
+			heal := &shaman.earthShieldHeal
+			shaman.earthShieldHealTemplate.Apply(heal)
+			heal.Effect.Target = character
+			heal.Init(sim)
+			heal.Cast(sim)
+
+			if charges == 1 {
+				// Can't remove auras while the OnDamageTaken callbacks are being iterated.
+				consumed = true
+				character.RemoveAuraOnNextAdvance(sim, EarthShieldAuraID)
+			} else {
+				character.ReplaceAura(sim, shaman.earthShieldAura(sim, character, charges-1, sim.CurrentTime+earthShieldHealCooldown))
+			}
+		},
+	}
+}
+
+// Returns the number of Earth Shield charges left on the target.
+func EarthShieldCharges(target *core.Character) int32 {
+	if !target.HasAura(EarthShieldAuraID) {
+		return 0
+	}
+	return target.NumStacks(EarthShieldAuraID)
+}
@@ -98,6 +98,11 @@ func (eleShaman *ElementalShaman) tryUseGCD(sim *core.Simulation) {
 		return
 	}
 
+	if eleShaman.HasAPLRotation() {
+		eleShaman.DoAPLRotation(sim)
+		return
+	}
+
 	newAction := eleShaman.rotation.ChooseAction(eleShaman, sim)
 	actionSuccessful := newAction.Cast(sim)
 	if actionSuccessful {
@@ -109,6 +114,34 @@ func (eleShaman *ElementalShaman) tryUseGCD(sim *core.Simulation) {
 	}
 }
 
+// Lightning Bolt and Chain Lightning can't be cast while moving, so use
+// shocks and totems instead.
+func (eleShaman *ElementalShaman) OnGCDReadyWhileMoving(sim *core.Simulation) {
+	if eleShaman.TryDropTotems(sim) {
+		return
+	}
+
+	if !eleShaman.IsOnCD(shaman.ShockCooldownID, sim.CurrentTime) {
+		target := eleShaman.CurrentTarget(sim)
+		var shock *core.SimpleSpell
+		if !eleShaman.FlameShockSpell.IsInUse() {
+			shock = eleShaman.NewFlameShock(sim, target)
+		} else {
+			shock = eleShaman.NewEarthShock(sim, target)
+		}
+
+		if success := shock.Cast(sim); success {
+			return
+		}
+	}
+
+	waitUntil := eleShaman.MovementEndsAt()
+	if eleShaman.IsOnCD(shaman.ShockCooldownID, sim.CurrentTime) {
+		waitUntil = core.MinDuration(waitUntil, eleShaman.CDReadyAt(shaman.ShockCooldownID))
+	}
+	eleShaman.WaitUntil(sim, waitUntil)
+}
+
 // Picks which attacks / abilities the Shaman does.
 type Rotation interface {
 	GetPresimOptions() *core.PresimOptions
@@ -131,7 +164,7 @@ type LBOnlyRotation struct {
 }
 
 func (rotation *LBOnlyRotation) ChooseAction(eleShaman *ElementalShaman, sim *core.Simulation) AgentAction {
-	return eleShaman.NewLightningBolt(sim, sim.GetPrimaryTarget(), false)
+	return eleShaman.NewLightningBolt(sim, eleShaman.CurrentTarget(sim), false)
 }
 
 func (rotation *LBOnlyRotation) OnActionAccepted(eleShaman *ElementalShaman, sim *core.Simulation, action AgentAction) {
@@ -151,9 +184,9 @@ type CLOnCDRotation struct {
 
 func (rotation *CLOnCDRotation) ChooseAction(eleShaman *ElementalShaman, sim *core.Simulation) AgentAction {
 	if eleShaman.IsOnCD(shaman.ChainLightningCooldownID, sim.CurrentTime) {
-		return eleShaman.NewLightningBolt(sim, sim.GetPrimaryTarget(), false)
+		return eleShaman.NewLightningBolt(sim, eleShaman.CurrentTarget(sim), false)
 	} else {
-		return eleShaman.NewChainLightning(sim, sim.GetPrimaryTarget(), false)
+		return eleShaman.NewChainLightning(sim, eleShaman.CurrentTarget(sim), false)
 	}
 }
 
@@ -176,17 +209,17 @@ type FixedRotation struct {
 
 func (rotation *FixedRotation) ChooseAction(eleShaman *ElementalShaman, sim *core.Simulation) AgentAction {
 	if rotation.numLBsSinceLastCL < rotation.numLBsPerCL {
-		return eleShaman.NewLightningBolt(sim, sim.GetPrimaryTarget(), false)
+		return eleShaman.NewLightningBolt(sim, eleShaman.CurrentTarget(sim), false)
 	}
 
 	if !eleShaman.IsOnCD(shaman.ChainLightningCooldownID, sim.CurrentTime) {
-		return eleShaman.NewChainLightning(sim, sim.GetPrimaryTarget(), false)
+		return eleShaman.NewChainLightning(sim, eleShaman.CurrentTarget(sim), false)
 	}
 
 	// If we have a temporary haste effect (like bloodlust or quags eye) then
 	// we should add LB casts instead of waiting
 	if eleShaman.HasTemporarySpellCastSpeedIncrease() {
-		return eleShaman.NewLightningBolt(sim, sim.GetPrimaryTarget(), false)
+		return eleShaman.NewLightningBolt(sim, eleShaman.CurrentTarget(sim), false)
 	}
 
 	return common.NewWaitAction(sim, eleShaman.GetCharacter(), eleShaman.GetRemainingCD(shaman.ChainLightningCooldownID, sim.CurrentTime), common.WaitReasonRotation)
@@ -222,10 +255,10 @@ type CLOnClearcastRotation struct {
 
 func (rotation *CLOnClearcastRotation) ChooseAction(eleShaman *ElementalShaman, sim *core.Simulation) AgentAction {
 	if eleShaman.IsOnCD(shaman.ChainLightningCooldownID, sim.CurrentTime) || !rotation.prevPrevCastProccedCC {
-		return eleShaman.NewLightningBolt(sim, sim.GetPrimaryTarget(), false)
+		return eleShaman.NewLightningBolt(sim, eleShaman.CurrentTarget(sim), false)
 	}
 
-	return eleShaman.NewChainLightning(sim, sim.GetPrimaryTarget(), false)
+	return eleShaman.NewChainLightning(sim, eleShaman.CurrentTarget(sim), false)
 }
 
 func (rotation *CLOnClearcastRotation) OnActionAccepted(eleShaman *ElementalShaman, sim *core.Simulation, action AgentAction) {
@@ -262,7 +295,7 @@ func (rotation *AdaptiveRotation) ChooseAction(eleShaman *ElementalShaman, sim *
 			lb *= 1.05
 		}
 		if lb+10 >= cl {
-			return eleShaman.NewLightningBolt(sim, sim.GetPrimaryTarget(), false)
+			return eleShaman.NewLightningBolt(sim, eleShaman.CurrentTarget(sim), false)
 		}
 	}
 
@@ -98,8 +98,15 @@ func (enh *EnhancementShaman) OnManaTick(sim *core.Simulation) {
 }
 
 func (enh *EnhancementShaman) tryUseGCD(sim *core.Simulation) {
+	if enh.HasAPLRotation() {
+		if enh.TryDropTotems(sim) {
+			return
+		}
+		enh.DoAPLRotation(sim)
+		return
+	}
 
-	target := sim.GetPrimaryTarget()
+	target := enh.CurrentTarget(sim)
 
 	if enh.Talents.Stormstrike && !enh.IsOnCD(shaman.StormstrikeCD, sim.CurrentTime) {
 		ss := enh.NewStormstrike(sim, target)
@@ -108,16 +115,7 @@ func (enh *EnhancementShaman) tryUseGCD(sim *core.Simulation) {
 		}
 		return
 	} else if !enh.IsOnCD(shaman.ShockCooldownID, sim.CurrentTime) {
-		var shock *core.SimpleSpell
-		if enh.Rotation.WeaveFlameShock && !enh.FlameShockSpell.IsInUse() {
-			shock = enh.NewFlameShock(sim, target)
-		} else if enh.Rotation.PrimaryShock == proto.EnhancementShaman_Rotation_Earth {
-			shock = enh.NewEarthShock(sim, target)
-		} else if enh.Rotation.PrimaryShock == proto.EnhancementShaman_Rotation_Frost {
-			shock = enh.NewFrostShock(sim, target)
-		}
-
-		if shock != nil {
+		if shock := enh.chooseShock(sim, target); shock != nil {
 			if success := shock.Cast(sim); !success {
 				enh.WaitForMana(sim, shock.ManaCost)
 			}
@@ -140,3 +138,38 @@ func (enh *EnhancementShaman) tryUseGCD(sim *core.Simulation) {
 	}
 	enh.WaitUntil(sim, nextEventAt)
 }
+
+// Stormstrike needs the target in melee range, but shocks and totems can
+// still be used while moving. Shamanistic Rage is a major cooldown, so it is
+// used as normal.
+func (enh *EnhancementShaman) OnGCDReadyWhileMoving(sim *core.Simulation) {
+	if !enh.IsOnCD(shaman.ShockCooldownID, sim.CurrentTime) {
+		if shock := enh.chooseShock(sim, enh.CurrentTarget(sim)); shock != nil {
+			if success := shock.Cast(sim); success {
+				return
+			}
+		}
+	}
+
+	if enh.TryDropTotems(sim) {
+		return
+	}
+
+	waitUntil := enh.MovementEndsAt()
+	if enh.IsOnCD(shaman.ShockCooldownID, sim.CurrentTime) {
+		waitUntil = core.MinDuration(waitUntil, enh.CDReadyAt(shaman.ShockCooldownID))
+	}
+	enh.WaitUntil(sim, waitUntil)
+}
+
+// Returns the shock to use next, or nil if the rotation doesn't use shocks.
+func (enh *EnhancementShaman) chooseShock(sim *core.Simulation, target *core.Target) *core.SimpleSpell {
+	if enh.Rotation.WeaveFlameShock && !enh.FlameShockSpell.IsInUse() {
+		return enh.NewFlameShock(sim, target)
+	} else if enh.Rotation.PrimaryShock == proto.EnhancementShaman_Rotation_Earth {
+		return enh.NewEarthShock(sim, target)
+	} else if enh.Rotation.PrimaryShock == proto.EnhancementShaman_Rotation_Frost {
+		return enh.NewFrostShock(sim, target)
+	}
+	return nil
+}
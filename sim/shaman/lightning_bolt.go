@@ -24,7 +24,8 @@ func (shaman *Shaman) newLightningBoltTemplate(sim *core.Simulation, isLightning
 			baseManaCost,
 			time.Millisecond*2500,
 			isLightningOverload),
-		Effect: shaman.newElectricSpellEffect(571, 652, 0.794, isLightningOverload),
+		Effect:       shaman.newElectricSpellEffect(571, 652, 0.794, isLightningOverload),
+		MissileSpeed: 40,
 	}
 
 	if !isLightningOverload && shaman.Talents.LightningOverload > 0 {
@@ -0,0 +1,70 @@
+package shaman
+
+import (
+	"github.com/wowsims/tbc/sim/core"
+)
+
+var ManaTideTotemCooldownID = core.NewCooldownID()
+
+// Mana Tide Totem is cast by the shaman, and each party member gets the buff
+// when it's cast. PartyBuffs.ManaTideTotems is only for shamans who aren't
+// part of the sim, and is ignored for parties with a shaman who uses it.
+func (shaman *Shaman) registerManaTideTotemCD() {
+	if !shaman.Talents.ManaTideTotem || !shaman.Totems.UseManaTide {
+		return
+	}
+	shaman.GivesManaTideTotem = true
+
+	actionID := core.ActionID{SpellID: 16190, CooldownID: ManaTideTotemCooldownID, Tag: int32(shaman.RaidIndex)}
+	baseManaCost := 320.0
+
+	var schedule *core.ManaTideTotemSchedule
+
+	shaman.AddMajorCooldown(core.MajorCooldown{
+		ActionID:   actionID,
+		CooldownID: ManaTideTotemCooldownID,
+		Cooldown:   core.ManaTideTotemCD,
+		UsesGCD:    true,
+		Type:       core.CooldownTypeMana,
+		CanActivate: func(sim *core.Simulation, character *core.Character) bool {
+			if character.CurrentMana() < baseManaCost {
+				return false
+			}
+
+			return true
+		},
+		ShouldActivate: func(sim *core.Simulation, character *core.Character) bool {
+			return schedule.ShouldActivate(sim)
+		},
+		ActivationFactory: func(sim *core.Simulation) core.CooldownActivation {
+			if schedule == nil {
+				partyMembers := make([]*core.Character, len(shaman.Party.Players))
+				for i, partyMember := range shaman.Party.Players {
+					partyMembers[i] = partyMember.GetCharacter()
+				}
+				schedule = core.NewManaTideTotemSchedule(partyMembers)
+			}
+			schedule.Init(sim)
+
+			castTemplate := core.SimpleCast{
+				Cast: core.Cast{
+					ActionID:     actionID,
+					Character:    shaman.GetCharacter(),
+					BaseManaCost: baseManaCost,
+					ManaCost:     baseManaCost,
+					GCD:          core.GCDDefault,
+					Cooldown:     core.ManaTideTotemCD,
+					OnCastComplete: func(sim *core.Simulation, cast *core.Cast) {
+						schedule.Activate(sim, actionID.Tag)
+					},
+				},
+			}
+
+			return func(sim *core.Simulation, character *core.Character) {
+				cast := castTemplate
+				cast.Init(sim)
+				cast.StartCast(sim)
+			}
+		},
+	})
+}
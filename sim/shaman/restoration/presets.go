@@ -0,0 +1,91 @@
+package restoration
+
+import (
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+var StandardTalents = &proto.ShamanTalents{
+	TotemicFocus:    5,
+	NaturesGuidance: 3,
+	TidalMastery:    5,
+
+	Purification:      5,
+	ManaTideTotem:     true,
+	NaturesSwiftness:  true,
+	ImprovedChainHeal: 2,
+	EarthShield:       true,
+}
+
+var restoShamOptions = &proto.RestorationShaman_Options{
+	WaterShield: true,
+	Bloodlust:   true,
+}
+
+var BasicTotems = &proto.ShamanTotems{
+	Earth:       proto.EarthTotem_TremorTotem,
+	Air:         proto.AirTotem_WrathOfAirTotem,
+	Water:       proto.WaterTotem_ManaSpringTotem,
+	UseManaTide: true,
+}
+
+var PlayerOptionsBasic = &proto.Player_RestorationShaman{
+	RestorationShaman: &proto.RestorationShaman{
+		Talents: StandardTalents,
+		Options: restoShamOptions,
+		Rotation: &proto.RestorationShaman_Rotation{
+			Totems:            BasicTotems,
+			HealThreshold:     0.1,
+			EarthShieldTarget: &proto.RaidTarget{TargetIndex: 0},
+		},
+	},
+}
+
+var FullRaidBuffs = &proto.RaidBuffs{
+	ArcaneBrilliance: true,
+	GiftOfTheWild:    proto.TristateEffect_TristateEffectImproved,
+}
+var FullIndividualBuffs = &proto.IndividualBuffs{
+	BlessingOfKings:  true,
+	BlessingOfWisdom: proto.TristateEffect_TristateEffectImproved,
+}
+
+var FullConsumes = &proto.Consumes{
+	Food:            proto.Food_FoodBlackenedBasilisk,
+	DefaultPotion:   proto.Potions_SuperManaPotion,
+	DefaultConjured: proto.Conjured_ConjuredDarkRune,
+}
+
+var P1Gear = items.EquipmentSpecFromStrings([]items.ItemStringSpec{
+	items.ItemStringSpec{
+		Name: "Cyclone Headdress",
+		Gems: []string{
+			"Bracing Earthstorm Diamond",
+			"Royal Nightseye",
+		},
+	},
+	items.ItemStringSpec{
+		Name: "Cyclone Shoulderpads",
+	},
+	items.ItemStringSpec{
+		Name: "Cyclone Hauberk",
+	},
+	items.ItemStringSpec{
+		Name: "Cyclone Gloves",
+	},
+	items.ItemStringSpec{
+		Name: "Cyclone Kilt",
+	},
+	items.ItemStringSpec{
+		Name: "Light's Justice",
+	},
+	items.ItemStringSpec{
+		Name: "Lower City Prayerbook",
+	},
+	items.ItemStringSpec{
+		Name: "Essence of the Martyr",
+	},
+	items.ItemStringSpec{
+		Name: "Totem of Healing Rains",
+	},
+})
@@ -0,0 +1,113 @@
+package restoration
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/shaman"
+)
+
+func RegisterRestorationShaman() {
+	core.RegisterAgentFactory(
+		proto.Player_RestorationShaman{},
+		func(character core.Character, options proto.Player) core.Agent {
+			return NewRestorationShaman(character, options)
+		},
+		func(player *proto.Player, spec interface{}) {
+			playerSpec, ok := spec.(*proto.Player_RestorationShaman)
+			if !ok {
+				panic("Invalid spec value for Restoration Shaman!")
+			}
+			player.Spec = playerSpec
+		},
+	)
+}
+
+// How long to wait before checking again, when nobody needs healing.
+const idleWaitDuration = time.Millisecond * 500
+
+func NewRestorationShaman(character core.Character, options proto.Player) *RestorationShaman {
+	restoShamOptions := options.GetRestorationShaman()
+	character.RejectAPLRotation("Restoration Shaman")
+
+	selfBuffs := shaman.SelfBuffs{
+		Bloodlust:   restoShamOptions.Options.Bloodlust,
+		WaterShield: restoShamOptions.Options.WaterShield,
+	}
+
+	totems := proto.ShamanTotems{}
+	if restoShamOptions.Rotation.Totems != nil {
+		totems = *restoShamOptions.Rotation.Totems
+	}
+
+	earthShieldTarget := proto.RaidTarget{TargetIndex: -1}
+	if restoShamOptions.Rotation.EarthShieldTarget != nil {
+		earthShieldTarget = *restoShamOptions.Rotation.EarthShieldTarget
+	}
+
+	return &RestorationShaman{
+		Shaman:               shaman.NewShaman(character, *restoShamOptions.Talents, totems, selfBuffs),
+		healThreshold:        restoShamOptions.Rotation.HealThreshold,
+		earthShieldRaidIndex: earthShieldTarget,
+	}
+}
+
+type RestorationShaman struct {
+	*shaman.Shaman
+
+	// Only heal players missing at least this fraction of their max health.
+	healThreshold float64
+
+	earthShieldRaidIndex proto.RaidTarget
+	earthShieldTarget    *core.Character
+}
+
+func (restoShaman *RestorationShaman) GetShaman() *shaman.Shaman {
+	return restoShaman.Shaman
+}
+
+func (restoShaman *RestorationShaman) Init(sim *core.Simulation) {
+	restoShaman.Shaman.Init(sim)
+
+	if restoShaman.Talents.EarthShield {
+		if earthShieldTargetAgent := sim.Raid.GetPlayerFromRaidTarget(restoShaman.earthShieldRaidIndex); earthShieldTargetAgent != nil {
+			restoShaman.earthShieldTarget = earthShieldTargetAgent.GetCharacter()
+		}
+	}
+}
+
+func (restoShaman *RestorationShaman) OnGCDReady(sim *core.Simulation) {
+	restoShaman.tryUseGCD(sim)
+}
+
+func (restoShaman *RestorationShaman) OnManaTick(sim *core.Simulation) {
+	if restoShaman.FinishedWaitingForManaAndGCDReady(sim) {
+		restoShaman.tryUseGCD(sim)
+	}
+}
+
+func (restoShaman *RestorationShaman) tryUseGCD(sim *core.Simulation) {
+	if restoShaman.TryDropTotems(sim) {
+		return
+	}
+
+	if restoShaman.earthShieldTarget != nil && shaman.EarthShieldCharges(restoShaman.earthShieldTarget) == 0 {
+		earthShield := restoShaman.NewEarthShield(sim, restoShaman.earthShieldTarget)
+		if success := earthShield.StartCast(sim); !success {
+			restoShaman.WaitForMana(sim, earthShield.GetManaCost())
+		}
+		return
+	}
+
+	target := core.MostInjuredCharacter(sim.Raid.AllCharacters(), nil)
+	if target == nil || target.MissingHealth() < target.MaxHealth()*restoShaman.healThreshold {
+		restoShaman.WaitUntil(sim, sim.CurrentTime+idleWaitDuration)
+		return
+	}
+
+	chainHeal := restoShaman.NewChainHeal(sim, target)
+	if success := chainHeal.Cast(sim); !success {
+		restoShaman.WaitForMana(sim, chainHeal.GetManaCost())
+	}
+}
@@ -72,6 +72,8 @@ func NewShaman(character core.Character, talents proto.ShamanTalents, totems pro
 	}
 
 	shaman.registerBloodlustCD()
+	shaman.registerManaTideTotemCD()
+	shaman.registerAPLActions()
 	shaman.applyTalents()
 
 	return shaman
@@ -149,6 +151,15 @@ type Shaman struct {
 	magmaTotemTemplate   core.SimpleSpellTemplate
 	novaTotemTemplate    core.SimpleSpellTemplate
 	FireTotemSpell       core.SimpleSpell
+
+	// Heals
+	chainHealSpell        core.SimpleHeal
+	chainHealCastTemplate core.SimpleHealTemplate
+
+	earthShieldSpell        core.SimpleCast
+	earthShieldCastTemplate core.SimpleCast
+	earthShieldHeal         core.SimpleHeal
+	earthShieldHealTemplate core.SimpleHealTemplate
 }
 
 // Implemented by each Shaman spec.
@@ -169,9 +180,6 @@ func (shaman *Shaman) AddPartyBuffs(partyBuffs *proto.PartyBuffs) {
 	if shaman.Totems.Fire == proto.FireTotem_TotemOfWrath {
 		partyBuffs.TotemOfWrath += 1
 	}
-	if shaman.Talents.ManaTideTotem {
-		partyBuffs.ManaTideTotems++
-	}
 
 	switch shaman.Totems.Water {
 	case proto.WaterTotem_ManaSpringTotem:
@@ -258,6 +266,10 @@ func (shaman *Shaman) Init(sim *core.Simulation) {
 	shaman.searingTotemTemplate = shaman.newSearingTotemTemplate(sim)
 	shaman.magmaTotemTemplate = shaman.newMagmaTotemTemplate(sim)
 	shaman.novaTotemTemplate = shaman.newNovaTotemTemplate(sim)
+
+	shaman.chainHealCastTemplate = shaman.newChainHealTemplate(sim)
+	shaman.earthShieldCastTemplate = shaman.newEarthShieldTemplate(sim)
+	shaman.earthShieldHealTemplate = shaman.newEarthShieldHealTemplate(sim)
 }
 
 func (shaman *Shaman) Reset(sim *core.Simulation) {
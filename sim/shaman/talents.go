@@ -161,8 +161,8 @@ func (shaman *Shaman) registerNaturesSwiftnessCD() {
 		Cooldown:   time.Minute * 3,
 		Type:       core.CooldownTypeDPS,
 		CanActivate: func(sim *core.Simulation, character *core.Character) bool {
-			// Don't use NS unless we're casting a full-length lightning bolt, which is
-			// the only spell shamans have with a cast longer than GCD.
+			// Don't use NS unless we're casting a full-length lightning bolt or
+			// chain heal, the only spells shamans have with a cast longer than GCD.
 			if character.HasTemporarySpellCastSpeedIncrease() {
 				return false
 			}
@@ -178,14 +178,14 @@ func (shaman *Shaman) registerNaturesSwiftnessCD() {
 					ActionID: actionID,
 					Expires:  core.NeverExpires,
 					OnCast: func(sim *core.Simulation, cast *core.Cast) {
-						if cast.ActionID.SpellID != SpellIDLB12 {
+						if !naturesSwiftnessAffects(cast) {
 							return
 						}
 
 						cast.CastTime = 0
 					},
 					OnCastComplete: func(sim *core.Simulation, cast *core.Cast) {
-						if cast.ActionID.SpellID != SpellIDLB12 {
+						if !naturesSwiftnessAffects(cast) {
 							return
 						}
 
@@ -201,6 +201,11 @@ func (shaman *Shaman) registerNaturesSwiftnessCD() {
 	})
 }
 
+// Whether Nature's Swiftness makes this cast instant.
+func naturesSwiftnessAffects(cast *core.Cast) bool {
+	return cast.ActionID.SpellID == SpellIDLB12 || cast.ActionID.SpellID == SpellIDChainHeal5
+}
+
 var WeaponMasteryAuraID = core.NewAuraID()
 
 func (shaman *Shaman) applyWeaponMastery() {
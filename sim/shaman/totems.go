@@ -248,7 +248,7 @@ func (shaman *Shaman) TryDropTotems(sim *core.Simulation) bool {
 				case proto.FireTotem_TotemOfWrath:
 					cast = shaman.NewTotemOfWrath(sim)
 				case proto.FireTotem_SearingTotem:
-					attackCast = shaman.NewSearingTotem(sim, sim.GetPrimaryTarget())
+					attackCast = shaman.NewSearingTotem(sim, shaman.CurrentTarget(sim))
 				case proto.FireTotem_MagmaTotem:
 					attackCast = shaman.NewMagmaTotem(sim)
 				case proto.FireTotem_FireNovaTotem:
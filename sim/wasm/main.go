@@ -8,6 +8,7 @@ import (
 
 	"github.com/wowsims/tbc/sim"
 	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/items"
 	proto "github.com/wowsims/tbc/sim/core/proto"
 	googleProto "google.golang.org/protobuf/proto"
 )
@@ -20,9 +21,14 @@ func main() {
 	c := make(chan struct{}, 0)
 
 	js.Global().Set("computeStats", js.FuncOf(computeStats))
+	js.Global().Set("cooldownOptimizer", js.FuncOf(cooldownOptimizer))
+	js.Global().Set("encounterList", js.FuncOf(encounterList))
 	js.Global().Set("gearList", js.FuncOf(gearList))
+	js.Global().Set("loadItemDatabase", js.FuncOf(loadItemDatabase))
+	js.Global().Set("partyOptimizer", js.FuncOf(partyOptimizer))
 	js.Global().Set("raidSim", js.FuncOf(raidSim))
 	js.Global().Set("raidSimAsync", js.FuncOf(raidSimAsync))
+	js.Global().Set("rotationOptimizer", js.FuncOf(rotationOptimizer))
 	js.Global().Set("statWeights", js.FuncOf(statWeights))
 	js.Global().Set("statWeightsAsync", js.FuncOf(statWeightsAsync))
 	js.Global().Call("wasmready")
@@ -49,6 +55,66 @@ func computeStats(this js.Value, args []js.Value) interface{} {
 	return outArray
 }
 
+func cooldownOptimizer(this js.Value, args []js.Value) interface{} {
+	cor := &proto.CooldownOptimizerRequest{}
+	if err := googleProto.Unmarshal(getArgsBinary(args[0]), cor); err != nil {
+		log.Printf("Failed to parse request: %s", err)
+		return nil
+	}
+	result := core.OptimizeCooldowns(cor)
+
+	outbytes, err := googleProto.Marshal(result)
+	if err != nil {
+		log.Printf("[ERROR] Failed to marshal result: %s", err.Error())
+		return nil
+	}
+
+	outArray := js.Global().Get("Uint8Array").New(len(outbytes))
+	js.CopyBytesToJS(outArray, outbytes)
+
+	return outArray
+}
+
+func encounterList(this js.Value, args []js.Value) interface{} {
+	elr := &proto.EncounterListRequest{}
+	if err := googleProto.Unmarshal(getArgsBinary(args[0]), elr); err != nil {
+		log.Printf("Failed to parse request: %s", err)
+		return nil
+	}
+	result := core.GetEncounterList(elr)
+
+	outbytes, err := googleProto.Marshal(result)
+	if err != nil {
+		log.Printf("[ERROR] Failed to marshal result: %s", err.Error())
+		return nil
+	}
+
+	outArray := js.Global().Get("Uint8Array").New(len(outbytes))
+	js.CopyBytesToJS(outArray, outbytes)
+
+	return outArray
+}
+
+func partyOptimizer(this js.Value, args []js.Value) interface{} {
+	por := &proto.PartyOptimizerRequest{}
+	if err := googleProto.Unmarshal(getArgsBinary(args[0]), por); err != nil {
+		log.Printf("Failed to parse request: %s", err)
+		return nil
+	}
+	result := core.OptimizeParties(por)
+
+	outbytes, err := googleProto.Marshal(result)
+	if err != nil {
+		log.Printf("[ERROR] Failed to marshal result: %s", err.Error())
+		return nil
+	}
+
+	outArray := js.Global().Get("Uint8Array").New(len(outbytes))
+	js.CopyBytesToJS(outArray, outbytes)
+
+	return outArray
+}
+
 func gearList(this js.Value, args []js.Value) interface{} {
 	glr := &proto.GearListRequest{}
 	if err := googleProto.Unmarshal(getArgsBinary(args[0]), glr); err != nil {
@@ -69,6 +135,20 @@ func gearList(this js.Value, args []js.Value) interface{} {
 	return outArray
 }
 
+// Replaces the built-in item data. Should be called before any other function.
+func loadItemDatabase(this js.Value, args []js.Value) interface{} {
+	db := &proto.ItemDatabase{}
+	if err := googleProto.Unmarshal(getArgsBinary(args[0]), db); err != nil {
+		log.Printf("Failed to parse item database: %s", err)
+		return false
+	}
+	if err := items.LoadDatabase(db); err != nil {
+		log.Printf("Failed to load item database: %s", err)
+		return false
+	}
+	return true
+}
+
 func raidSim(this js.Value, args []js.Value) interface{} {
 	rsr := &proto.RaidSimRequest{}
 	if err := googleProto.Unmarshal(getArgsBinary(args[0]), rsr); err != nil {
@@ -103,6 +183,26 @@ func raidSimAsync(this js.Value, args []js.Value) interface{} {
 	return result
 }
 
+func rotationOptimizer(this js.Value, args []js.Value) interface{} {
+	ror := &proto.RotationOptimizerRequest{}
+	if err := googleProto.Unmarshal(getArgsBinary(args[0]), ror); err != nil {
+		log.Printf("Failed to parse request: %s", err)
+		return nil
+	}
+	result := core.OptimizeRotation(ror)
+
+	outbytes, err := googleProto.Marshal(result)
+	if err != nil {
+		log.Printf("[ERROR] Failed to marshal result: %s", err.Error())
+		return nil
+	}
+
+	outArray := js.Global().Get("Uint8Array").New(len(outbytes))
+	js.CopyBytesToJS(outArray, outbytes)
+
+	return outArray
+}
+
 func statWeights(this js.Value, args []js.Value) interface{} {
 	swr := &proto.StatWeightsRequest{}
 	if err := googleProto.Unmarshal(getArgsBinary(args[0]), swr); err != nil {
@@ -21,6 +21,7 @@ import (
 	dist "github.com/wowsims/tbc/binary_dist"
 	"github.com/wowsims/tbc/sim"
 	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/items"
 	proto "github.com/wowsims/tbc/sim/core/proto"
 
 	googleProto "google.golang.org/protobuf/proto"
@@ -45,9 +46,17 @@ func main() {
 	var host = flag.String("host", ":3333", "URL to host the interface on.")
 	var launch = flag.Bool("launch", true, "auto launch browser")
 	var skipVersionCheck = flag.Bool("nvc", false, "set true to skip version check")
+	var itemDatabase = flag.String("itemDatabase", "", "Path to an item database file (.json or binary proto) to use instead of the built-in item data.")
 
 	flag.Parse()
 
+	if *itemDatabase != "" {
+		if err := items.LoadDatabaseFile(*itemDatabase); err != nil {
+			log.Fatalf("Failed to load item database: %s", err)
+		}
+		log.Printf("Loaded %d items from %s", len(items.Items), *itemDatabase)
+	}
+
 	fmt.Printf("Version: %s\n", Version)
 	if !*skipVersionCheck && Version != "development" {
 		go func() {
@@ -233,6 +242,10 @@ func runServer(useFS bool, host string, launchBrowser bool, simName string, wasm
 	http.HandleFunc("/individualSim", handleAPI)
 	http.HandleFunc("/raidSim", handleAPI)
 	http.HandleFunc("/gearList", handleAPI)
+	http.HandleFunc("/encounterList", handleAPI)
+	http.HandleFunc("/partyOptimizer", handleAPI)
+	http.HandleFunc("/rotationOptimizer", handleAPI)
+	http.HandleFunc("/cooldownOptimizer", handleAPI)
 	http.HandleFunc("/", func(resp http.ResponseWriter, req *http.Request) {
 		resp.Header().Add("Cache-Control", "no-cache")
 		if strings.HasSuffix(req.URL.Path, "/tbc/") {
@@ -345,6 +358,18 @@ var handlers = map[string]apiHandler{
 	"/gearList": {msg: func() googleProto.Message { return &proto.GearListRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
 		return core.GetGearList(msg.(*proto.GearListRequest))
 	}},
+	"/encounterList": {msg: func() googleProto.Message { return &proto.EncounterListRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.GetEncounterList(msg.(*proto.EncounterListRequest))
+	}},
+	"/partyOptimizer": {msg: func() googleProto.Message { return &proto.PartyOptimizerRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.OptimizeParties(msg.(*proto.PartyOptimizerRequest))
+	}},
+	"/rotationOptimizer": {msg: func() googleProto.Message { return &proto.RotationOptimizerRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.OptimizeRotation(msg.(*proto.RotationOptimizerRequest))
+	}},
+	"/cooldownOptimizer": {msg: func() googleProto.Message { return &proto.CooldownOptimizerRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.OptimizeCooldowns(msg.(*proto.CooldownOptimizerRequest))
+	}},
 }
 
 // handleAPI is generic handler for any api function using protos.
@@ -51,6 +51,9 @@ export class ActionId {
 				break;
 			case OtherAction.OtherActionPet:
 				break;
+			case OtherAction.OtherActionEncounterDamage:
+				baseName = 'Encounter Damage';
+				break;
 		}
 		this.baseName = baseName;
 		this.name = name || baseName;
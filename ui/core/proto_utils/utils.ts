@@ -30,23 +30,23 @@ import { Stats } from './stats.js';
 
 import * as Gems from '/tbc/core/proto_utils/gems.js';
 
-import { BalanceDruid, BalanceDruid_Rotation as BalanceDruidRotation, DruidTalents, BalanceDruid_Options as BalanceDruidOptions} from '/tbc/core/proto/druid.js';
-import { ElementalShaman, EnhancementShaman_Rotation as EnhancementShamanRotation, ElementalShaman_Rotation as ElementalShamanRotation, ShamanTalents, ElementalShaman_Options as ElementalShamanOptions, EnhancementShaman_Options as EnhancementShamanOptions, EnhancementShaman } from '/tbc/core/proto/shaman.js';
+import { BalanceDruid, BalanceDruid_Rotation as BalanceDruidRotation, DruidTalents, BalanceDruid_Options as BalanceDruidOptions, RestorationDruid, RestorationDruid_Rotation as RestorationDruidRotation, RestorationDruid_Options as RestorationDruidOptions } from '/tbc/core/proto/druid.js';
+import { ElementalShaman, EnhancementShaman_Rotation as EnhancementShamanRotation, ElementalShaman_Rotation as ElementalShamanRotation, ShamanTalents, ElementalShaman_Options as ElementalShamanOptions, EnhancementShaman_Options as EnhancementShamanOptions, EnhancementShaman, RestorationShaman, RestorationShaman_Rotation as RestorationShamanRotation, RestorationShaman_Options as RestorationShamanOptions } from '/tbc/core/proto/shaman.js';
 import { Hunter, Hunter_Rotation as HunterRotation, HunterTalents, Hunter_Options as HunterOptions } from '/tbc/core/proto/hunter.js';
 import { Mage, Mage_Rotation as MageRotation, MageTalents, Mage_Options as MageOptions } from '/tbc/core/proto/mage.js';
 import { Rogue, Rogue_Rotation as RogueRotation, RogueTalents, Rogue_Options as RogueOptions } from '/tbc/core/proto/rogue.js';
-import { RetributionPaladin, RetributionPaladin_Rotation as RetributionPaladinRotation, PaladinTalents, RetributionPaladin_Options as RetributionPaladinOptions } from '/tbc/core/proto/paladin.js';
-import { ShadowPriest, ShadowPriest_Rotation as ShadowPriestRotation, PriestTalents, ShadowPriest_Options as ShadowPriestOptions } from '/tbc/core/proto/priest.js';
+import { RetributionPaladin, RetributionPaladin_Rotation as RetributionPaladinRotation, PaladinTalents, RetributionPaladin_Options as RetributionPaladinOptions, HolyPaladin, HolyPaladin_Rotation as HolyPaladinRotation, HolyPaladin_Options as HolyPaladinOptions } from '/tbc/core/proto/paladin.js';
+import { ShadowPriest, ShadowPriest_Rotation as ShadowPriestRotation, PriestTalents, ShadowPriest_Options as ShadowPriestOptions, HolyPriest, HolyPriest_Rotation as HolyPriestRotation, HolyPriest_Options as HolyPriestOptions, SmitePriest, SmitePriest_Rotation as SmitePriestRotation, SmitePriest_Options as SmitePriestOptions } from '/tbc/core/proto/priest.js';
 import { Warlock, Warlock_Rotation as WarlockRotation, WarlockTalents, Warlock_Options as WarlockOptions } from '/tbc/core/proto/warlock.js';
 import { Warrior, Warrior_Rotation as WarriorRotation, WarriorTalents, Warrior_Options as WarriorOptions } from '/tbc/core/proto/warrior.js';
 
-export type DruidSpecs = Spec.SpecBalanceDruid;
+export type DruidSpecs = [Spec.SpecBalanceDruid, Spec.SpecRestorationDruid];
 export type HunterSpecs = Spec.SpecHunter;
 export type MageSpecs = Spec.SpecMage;
 export type RogueSpecs = Spec.SpecRogue;
-export type PaladinSpecs = Spec.SpecRetributionPaladin;
-export type PriestSpecs = Spec.SpecShadowPriest;
-export type ShamanSpecs = [Spec.SpecElementalShaman, Spec.SpecEnhancementShaman];
+export type PaladinSpecs = [Spec.SpecRetributionPaladin, Spec.SpecHolyPaladin];
+export type PriestSpecs = [Spec.SpecShadowPriest, Spec.SpecHolyPriest, Spec.SpecSmitePriest];
+export type ShamanSpecs = [Spec.SpecElementalShaman, Spec.SpecEnhancementShaman, Spec.SpecRestorationShaman];
 export type WarlockSpecs = Spec.SpecWarlock;
 export type WarriorSpecs = Spec.SpecWarrior;
 
@@ -56,26 +56,36 @@ export const NUM_SPECS = getEnumValues(Spec).length;
 // Currently this is only used for the order of the paladin blessings UI.
 export const naturalSpecOrder: Array<Spec> = [
 	Spec.SpecBalanceDruid,
+	Spec.SpecRestorationDruid,
 	Spec.SpecHunter,
 	Spec.SpecMage,
 	Spec.SpecRetributionPaladin,
+	Spec.SpecHolyPaladin,
 	Spec.SpecShadowPriest,
+	Spec.SpecHolyPriest,
+	Spec.SpecSmitePriest,
 	Spec.SpecRogue,
 	Spec.SpecElementalShaman,
 	Spec.SpecEnhancementShaman,
+	Spec.SpecRestorationShaman,
 	Spec.SpecWarlock,
 	Spec.SpecWarrior,
 ];
 
 export const specNames: Record<Spec, string> = {
   [Spec.SpecBalanceDruid]: 'Balance Druid',
+  [Spec.SpecRestorationDruid]: 'Restoration Druid',
   [Spec.SpecElementalShaman]: 'Elemental Shaman',
   [Spec.SpecEnhancementShaman]: 'Enhancement Shaman',
+  [Spec.SpecRestorationShaman]: 'Restoration Shaman',
   [Spec.SpecHunter]: 'Hunter',
   [Spec.SpecMage]: 'Mage',
   [Spec.SpecRogue]: 'Rogue',
   [Spec.SpecRetributionPaladin]: 'Retribution Paladin',
+  [Spec.SpecHolyPaladin]: 'Holy Paladin',
   [Spec.SpecShadowPriest]: 'Shadow Priest',
+  [Spec.SpecHolyPriest]: 'Holy Priest',
+  [Spec.SpecSmitePriest]: 'Smite Priest',
   [Spec.SpecWarlock]: 'Warlock',
   [Spec.SpecWarrior]: 'Warrior',
 };
@@ -95,13 +105,18 @@ export const classColors: Record<Class, string> = {
 
 export const specIconsLarge: Record<Spec, string> = {
   [Spec.SpecBalanceDruid]: 'https://wow.zamimg.com/images/wow/icons/large/spell_nature_starfall.jpg',
+  [Spec.SpecRestorationDruid]: 'https://wow.zamimg.com/images/wow/icons/large/spell_nature_healingtouch.jpg',
   [Spec.SpecElementalShaman]: 'https://wow.zamimg.com/images/wow/icons/large/spell_nature_lightning.jpg',
   [Spec.SpecEnhancementShaman]: 'https://wow.zamimg.com/images/wow/icons/large/ability_shaman_stormstrike.jpg', // TODO: Fix enh icon?
+  [Spec.SpecRestorationShaman]: 'https://wow.zamimg.com/images/wow/icons/large/spell_nature_magicimmunity.jpg',
   [Spec.SpecHunter]: 'https://wow.zamimg.com/images/wow/icons/large/ability_marksmanship.jpg',
   [Spec.SpecMage]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_magicalsentry.jpg',
   [Spec.SpecRogue]: 'https://wow.zamimg.com/images/wow/icons/large/ability_rogue_eviscerate.jpg',
   [Spec.SpecRetributionPaladin]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_auraoflight.jpg',
+  [Spec.SpecHolyPaladin]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_holybolt.jpg',
   [Spec.SpecShadowPriest]: 'https://wow.zamimg.com/images/wow/icons/large/spell_shadow_shadowwordpain.jpg',
+  [Spec.SpecHolyPriest]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_guardianspirit.jpg',
+  [Spec.SpecSmitePriest]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_holysmite.jpg',
   [Spec.SpecWarlock]: 'https://wow.zamimg.com/images/wow/icons/large/spell_shadow_metamorphosis.jpg',
   [Spec.SpecWarrior]: 'https://wow.zamimg.com/images/wow/icons/large/ability_warrior_innerrage.jpg',
 };
@@ -157,13 +172,18 @@ export const talentTreeIcons: Record<Class, Array<string>> = {
 
 export const titleIcons: Record<Spec, string> = {
   [Spec.SpecBalanceDruid]: '/tbc/assets/balance_druid_icon.png',
+  [Spec.SpecRestorationDruid]: 'https://wow.zamimg.com/images/wow/icons/large/spell_nature_healingtouch.jpg',
   [Spec.SpecElementalShaman]: '/tbc/assets/elemental_shaman_icon.png',
   [Spec.SpecEnhancementShaman]: '/tbc/assets/enhancement_shaman_icon.png',
+  [Spec.SpecRestorationShaman]: 'https://wow.zamimg.com/images/wow/icons/large/spell_nature_magicimmunity.jpg',
   [Spec.SpecHunter]: '/tbc/assets/hunter_icon.png',
   [Spec.SpecMage]: '/tbc/assets/mage_icon.png',
   [Spec.SpecRogue]: 'https://wow.zamimg.com/images/wow/icons/large/ability_rogue_eviscerate.jpg',
   [Spec.SpecRetributionPaladin]: '/tbc/assets/retribution_icon.png',
+  [Spec.SpecHolyPaladin]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_holybolt.jpg',
   [Spec.SpecShadowPriest]: '/tbc/assets/shadow_priest_icon.png',
+  [Spec.SpecHolyPriest]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_guardianspirit.jpg',
+  [Spec.SpecSmitePriest]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_holysmite.jpg',
   [Spec.SpecWarlock]: 'https://wow.zamimg.com/images/wow/icons/large/spell_shadow_metamorphosis.jpg',
   [Spec.SpecWarrior]: '/tbc/assets/warrior_icon.png',
 };
@@ -195,24 +215,34 @@ export const raidSimSiteUrl = new URL(`${window.location.protocol}//${window.loc
 
 export type RotationUnion =
 		BalanceDruidRotation |
+		RestorationDruidRotation |
 		ElementalShamanRotation |
     EnhancementShamanRotation |
+		RestorationShamanRotation |
 		HunterRotation |
 		MageRotation |
 		RogueRotation |
 		RetributionPaladinRotation |
+		HolyPaladinRotation |
 		ShadowPriestRotation |
+		HolyPriestRotation |
+		SmitePriestRotation |
 		WarlockRotation |
 		WarriorRotation;
 export type SpecRotation<T extends Spec> =
 		T extends Spec.SpecBalanceDruid ? BalanceDruidRotation :
+		T extends Spec.SpecRestorationDruid ? RestorationDruidRotation :
 		T extends Spec.SpecElementalShaman ? ElementalShamanRotation :
     T extends Spec.SpecEnhancementShaman ? EnhancementShamanRotation :
+		T extends Spec.SpecRestorationShaman ? RestorationShamanRotation :
 		T extends Spec.SpecHunter ? HunterRotation :
 		T extends Spec.SpecMage ? MageRotation :
 		T extends Spec.SpecRogue ? RogueRotation :
 		T extends Spec.SpecRetributionPaladin ? RetributionPaladinRotation :
+		T extends Spec.SpecHolyPaladin ? HolyPaladinRotation :
 		T extends Spec.SpecShadowPriest ? ShadowPriestRotation :
+		T extends Spec.SpecHolyPriest ? HolyPriestRotation :
+		T extends Spec.SpecSmitePriest ? SmitePriestRotation :
 		T extends Spec.SpecWarlock ? WarlockRotation :
 		T extends Spec.SpecWarrior ? WarriorRotation :
 		ElementalShamanRotation; // Should never reach this case
@@ -229,61 +259,86 @@ export type TalentsUnion =
 		WarriorTalents;
 export type SpecTalents<T extends Spec> =
 		T extends Spec.SpecBalanceDruid ? DruidTalents :
+		T extends Spec.SpecRestorationDruid ? DruidTalents :
 		T extends Spec.SpecElementalShaman ? ShamanTalents :
     T extends Spec.SpecEnhancementShaman ? ShamanTalents :
+		T extends Spec.SpecRestorationShaman ? ShamanTalents :
 		T extends Spec.SpecHunter ? HunterTalents :
 		T extends Spec.SpecMage ? MageTalents :
 		T extends Spec.SpecRogue ? RogueTalents :
 		T extends Spec.SpecRetributionPaladin ? PaladinTalents :
+		T extends Spec.SpecHolyPaladin ? PaladinTalents :
 		T extends Spec.SpecShadowPriest ? PriestTalents :
+		T extends Spec.SpecHolyPriest ? PriestTalents :
+		T extends Spec.SpecSmitePriest ? PriestTalents :
 		T extends Spec.SpecWarlock ? WarlockTalents :
 		T extends Spec.SpecWarrior ? WarriorTalents :
 		ShamanTalents; // Should never reach this case
 
 export type SpecOptionsUnion =
 		BalanceDruidOptions |
+		RestorationDruidOptions |
 		ElementalShamanOptions |
     EnhancementShamanOptions |
+		RestorationShamanOptions |
 		HunterOptions |
 		MageOptions |
 		RogueOptions |
 		RetributionPaladinOptions |
+		HolyPaladinOptions |
 		ShadowPriestOptions |
+		HolyPriestOptions |
+		SmitePriestOptions |
 		WarlockOptions |
 		WarriorOptions;
 export type SpecOptions<T extends Spec> =
 		T extends Spec.SpecBalanceDruid ? BalanceDruidOptions :
+		T extends Spec.SpecRestorationDruid ? RestorationDruidOptions :
 		T extends Spec.SpecElementalShaman ? ElementalShamanOptions :
     T extends Spec.SpecEnhancementShaman ? EnhancementShamanOptions :
+		T extends Spec.SpecRestorationShaman ? RestorationShamanOptions :
 		T extends Spec.SpecHunter ? HunterOptions :
 		T extends Spec.SpecMage ? MageOptions :
 		T extends Spec.SpecRogue ? RogueOptions :
 		T extends Spec.SpecRetributionPaladin ? RetributionPaladinOptions :
+		T extends Spec.SpecHolyPaladin ? HolyPaladinOptions :
 		T extends Spec.SpecShadowPriest ? ShadowPriestOptions :
+		T extends Spec.SpecHolyPriest ? HolyPriestOptions :
+		T extends Spec.SpecSmitePriest ? SmitePriestOptions :
 		T extends Spec.SpecWarlock ? WarlockOptions :
 		T extends Spec.SpecWarrior ? WarriorOptions :
 		ElementalShamanOptions; // Should never reach this case
 
 export type SpecProtoUnion =
 		BalanceDruid |
+		RestorationDruid |
 		ElementalShaman |
     EnhancementShaman |
+		RestorationShaman |
 		Hunter |
 		Mage |
 		Rogue |
 		RetributionPaladin |
+		HolyPaladin |
 		ShadowPriest |
+		HolyPriest |
+		SmitePriest |
 		Warlock |
 		Warrior;
 export type SpecProto<T extends Spec> =
 		T extends Spec.SpecBalanceDruid ? BalanceDruid :
+		T extends Spec.SpecRestorationDruid ? RestorationDruid :
 		T extends Spec.SpecElementalShaman ? ElementalShaman :
     T extends Spec.SpecEnhancementShaman ? EnhancementShaman :
+		T extends Spec.SpecRestorationShaman ? RestorationShaman :
 		T extends Spec.SpecHunter ? Hunter :
 		T extends Spec.SpecMage ? Mage :
 		T extends Spec.SpecRogue ? Rogue :
 		T extends Spec.SpecRetributionPaladin ? RetributionPaladin :
+		T extends Spec.SpecHolyPaladin ? HolyPaladin :
 		T extends Spec.SpecShadowPriest ? ShadowPriest :
+		T extends Spec.SpecHolyPriest ? HolyPriest :
+		T extends Spec.SpecSmitePriest ? SmitePriest :
 		T extends Spec.SpecWarlock ? Warlock :
 		T extends Spec.SpecWarrior ? Warrior :
 		ElementalShaman; // Should never reach this case
@@ -340,6 +395,34 @@ export const specTypeFunctions: Partial<Record<Spec, SpecTypeFunctions<any>>> =
 				? player.spec.balanceDruid.options || BalanceDruidOptions.create()
 				: BalanceDruidOptions.create(),
   },
+  [Spec.SpecRestorationDruid]: {
+    rotationCreate: () => RestorationDruidRotation.create(),
+    rotationEquals: (a, b) => RestorationDruidRotation.equals(a as RestorationDruidRotation, b as RestorationDruidRotation),
+    rotationCopy: (a) => RestorationDruidRotation.clone(a as RestorationDruidRotation),
+    rotationToJson: (a) => RestorationDruidRotation.toJson(a as RestorationDruidRotation),
+    rotationFromJson: (obj) => RestorationDruidRotation.fromJson(obj),
+    rotationFromPlayer: (player) => player.spec.oneofKind == 'restorationDruid'
+				? player.spec.restorationDruid.rotation || RestorationDruidRotation.create()
+				: RestorationDruidRotation.create(),
+
+    talentsCreate: () => DruidTalents.create(),
+    talentsEquals: (a, b) => DruidTalents.equals(a as DruidTalents, b as DruidTalents),
+    talentsCopy: (a) => DruidTalents.clone(a as DruidTalents),
+    talentsToJson: (a) => DruidTalents.toJson(a as DruidTalents),
+    talentsFromJson: (obj) => DruidTalents.fromJson(obj),
+    talentsFromPlayer: (player) => player.spec.oneofKind == 'restorationDruid'
+				? player.spec.restorationDruid.talents || DruidTalents.create()
+				: DruidTalents.create(),
+
+    optionsCreate: () => RestorationDruidOptions.create(),
+    optionsEquals: (a, b) => RestorationDruidOptions.equals(a as RestorationDruidOptions, b as RestorationDruidOptions),
+    optionsCopy: (a) => RestorationDruidOptions.clone(a as RestorationDruidOptions),
+    optionsToJson: (a) => RestorationDruidOptions.toJson(a as RestorationDruidOptions),
+    optionsFromJson: (obj) => RestorationDruidOptions.fromJson(obj),
+    optionsFromPlayer: (player) => player.spec.oneofKind == 'restorationDruid'
+				? player.spec.restorationDruid.options || RestorationDruidOptions.create()
+				: RestorationDruidOptions.create(),
+  },
   [Spec.SpecElementalShaman]: {
     rotationCreate: () => ElementalShamanRotation.create(),
     rotationEquals: (a, b) => ElementalShamanRotation.equals(a as ElementalShamanRotation, b as ElementalShamanRotation),
@@ -396,6 +479,34 @@ export const specTypeFunctions: Partial<Record<Spec, SpecTypeFunctions<any>>> =
 				? player.spec.enhancementShaman.options || EnhancementShamanOptions.create()
 				: EnhancementShamanOptions.create(),
   },
+  [Spec.SpecRestorationShaman]: {
+    rotationCreate: () => RestorationShamanRotation.create(),
+    rotationEquals: (a, b) => RestorationShamanRotation.equals(a as RestorationShamanRotation, b as RestorationShamanRotation),
+    rotationCopy: (a) => RestorationShamanRotation.clone(a as RestorationShamanRotation),
+    rotationToJson: (a) => RestorationShamanRotation.toJson(a as RestorationShamanRotation),
+    rotationFromJson: (obj) => RestorationShamanRotation.fromJson(obj),
+    rotationFromPlayer: (player) => player.spec.oneofKind == 'restorationShaman'
+				? player.spec.restorationShaman.rotation || RestorationShamanRotation.create()
+				: RestorationShamanRotation.create(),
+
+    talentsCreate: () => ShamanTalents.create(),
+    talentsEquals: (a, b) => ShamanTalents.equals(a as ShamanTalents, b as ShamanTalents),
+    talentsCopy: (a) => ShamanTalents.clone(a as ShamanTalents),
+    talentsToJson: (a) => ShamanTalents.toJson(a as ShamanTalents),
+    talentsFromJson: (obj) => ShamanTalents.fromJson(obj),
+    talentsFromPlayer: (player) => player.spec.oneofKind == 'restorationShaman'
+				? player.spec.restorationShaman.talents || ShamanTalents.create()
+				: ShamanTalents.create(),
+
+    optionsCreate: () => RestorationShamanOptions.create(),
+    optionsEquals: (a, b) => RestorationShamanOptions.equals(a as RestorationShamanOptions, b as RestorationShamanOptions),
+    optionsCopy: (a) => RestorationShamanOptions.clone(a as RestorationShamanOptions),
+    optionsToJson: (a) => RestorationShamanOptions.toJson(a as RestorationShamanOptions),
+    optionsFromJson: (obj) => RestorationShamanOptions.fromJson(obj),
+    optionsFromPlayer: (player) => player.spec.oneofKind == 'restorationShaman'
+				? player.spec.restorationShaman.options || RestorationShamanOptions.create()
+				: RestorationShamanOptions.create(),
+  },
   [Spec.SpecHunter]: {
     rotationCreate: () => HunterRotation.create(),
     rotationEquals: (a, b) => HunterRotation.equals(a as HunterRotation, b as HunterRotation),
@@ -480,6 +591,34 @@ export const specTypeFunctions: Partial<Record<Spec, SpecTypeFunctions<any>>> =
 				? player.spec.retributionPaladin.options || RetributionPaladinOptions.create()
 				: RetributionPaladinOptions.create(),
   },
+  [Spec.SpecHolyPaladin]: {
+    rotationCreate: () => HolyPaladinRotation.create(),
+    rotationEquals: (a, b) => HolyPaladinRotation.equals(a as HolyPaladinRotation, b as HolyPaladinRotation),
+    rotationCopy: (a) => HolyPaladinRotation.clone(a as HolyPaladinRotation),
+    rotationToJson: (a) => HolyPaladinRotation.toJson(a as HolyPaladinRotation),
+    rotationFromJson: (obj) => HolyPaladinRotation.fromJson(obj),
+    rotationFromPlayer: (player) => player.spec.oneofKind == 'holyPaladin'
+				? player.spec.holyPaladin.rotation || HolyPaladinRotation.create()
+				: HolyPaladinRotation.create(),
+
+    talentsCreate: () => PaladinTalents.create(),
+    talentsEquals: (a, b) => PaladinTalents.equals(a as PaladinTalents, b as PaladinTalents),
+    talentsCopy: (a) => PaladinTalents.clone(a as PaladinTalents),
+    talentsToJson: (a) => PaladinTalents.toJson(a as PaladinTalents),
+    talentsFromJson: (obj) => PaladinTalents.fromJson(obj),
+    talentsFromPlayer: (player) => player.spec.oneofKind == 'holyPaladin'
+				? player.spec.holyPaladin.talents || PaladinTalents.create()
+				: PaladinTalents.create(),
+
+    optionsCreate: () => HolyPaladinOptions.create(),
+    optionsEquals: (a, b) => HolyPaladinOptions.equals(a as HolyPaladinOptions, b as HolyPaladinOptions),
+    optionsCopy: (a) => HolyPaladinOptions.clone(a as HolyPaladinOptions),
+    optionsToJson: (a) => HolyPaladinOptions.toJson(a as HolyPaladinOptions),
+    optionsFromJson: (obj) => HolyPaladinOptions.fromJson(obj),
+    optionsFromPlayer: (player) => player.spec.oneofKind == 'holyPaladin'
+				? player.spec.holyPaladin.options || HolyPaladinOptions.create()
+				: HolyPaladinOptions.create(),
+  },
   [Spec.SpecRogue]: {
     rotationCreate: () => RogueRotation.create(),
     rotationEquals: (a, b) => RogueRotation.equals(a as RogueRotation, b as RogueRotation),
@@ -536,6 +675,62 @@ export const specTypeFunctions: Partial<Record<Spec, SpecTypeFunctions<any>>> =
 				? player.spec.shadowPriest.options || ShadowPriestOptions.create()
 				: ShadowPriestOptions.create(),
   },
+  [Spec.SpecHolyPriest]: {
+    rotationCreate: () => HolyPriestRotation.create(),
+    rotationEquals: (a, b) => HolyPriestRotation.equals(a as HolyPriestRotation, b as HolyPriestRotation),
+    rotationCopy: (a) => HolyPriestRotation.clone(a as HolyPriestRotation),
+    rotationToJson: (a) => HolyPriestRotation.toJson(a as HolyPriestRotation),
+    rotationFromJson: (obj) => HolyPriestRotation.fromJson(obj),
+    rotationFromPlayer: (player) => player.spec.oneofKind == 'holyPriest'
+				? player.spec.holyPriest.rotation || HolyPriestRotation.create()
+				: HolyPriestRotation.create(),
+
+    talentsCreate: () => PriestTalents.create(),
+    talentsEquals: (a, b) => PriestTalents.equals(a as PriestTalents, b as PriestTalents),
+    talentsCopy: (a) => PriestTalents.clone(a as PriestTalents),
+    talentsToJson: (a) => PriestTalents.toJson(a as PriestTalents),
+    talentsFromJson: (obj) => PriestTalents.fromJson(obj),
+    talentsFromPlayer: (player) => player.spec.oneofKind == 'holyPriest'
+				? player.spec.holyPriest.talents || PriestTalents.create()
+				: PriestTalents.create(),
+
+    optionsCreate: () => HolyPriestOptions.create(),
+    optionsEquals: (a, b) => HolyPriestOptions.equals(a as HolyPriestOptions, b as HolyPriestOptions),
+    optionsCopy: (a) => HolyPriestOptions.clone(a as HolyPriestOptions),
+    optionsToJson: (a) => HolyPriestOptions.toJson(a as HolyPriestOptions),
+    optionsFromJson: (obj) => HolyPriestOptions.fromJson(obj),
+    optionsFromPlayer: (player) => player.spec.oneofKind == 'holyPriest'
+				? player.spec.holyPriest.options || HolyPriestOptions.create()
+				: HolyPriestOptions.create(),
+  },
+  [Spec.SpecSmitePriest]: {
+    rotationCreate: () => SmitePriestRotation.create(),
+    rotationEquals: (a, b) => SmitePriestRotation.equals(a as SmitePriestRotation, b as SmitePriestRotation),
+    rotationCopy: (a) => SmitePriestRotation.clone(a as SmitePriestRotation),
+    rotationToJson: (a) => SmitePriestRotation.toJson(a as SmitePriestRotation),
+    rotationFromJson: (obj) => SmitePriestRotation.fromJson(obj),
+    rotationFromPlayer: (player) => player.spec.oneofKind == 'smitePriest'
+				? player.spec.smitePriest.rotation || SmitePriestRotation.create()
+				: SmitePriestRotation.create(),
+
+    talentsCreate: () => PriestTalents.create(),
+    talentsEquals: (a, b) => PriestTalents.equals(a as PriestTalents, b as PriestTalents),
+    talentsCopy: (a) => PriestTalents.clone(a as PriestTalents),
+    talentsToJson: (a) => PriestTalents.toJson(a as PriestTalents),
+    talentsFromJson: (obj) => PriestTalents.fromJson(obj),
+    talentsFromPlayer: (player) => player.spec.oneofKind == 'smitePriest'
+				? player.spec.smitePriest.talents || PriestTalents.create()
+				: PriestTalents.create(),
+
+    optionsCreate: () => SmitePriestOptions.create(),
+    optionsEquals: (a, b) => SmitePriestOptions.equals(a as SmitePriestOptions, b as SmitePriestOptions),
+    optionsCopy: (a) => SmitePriestOptions.clone(a as SmitePriestOptions),
+    optionsToJson: (a) => SmitePriestOptions.toJson(a as SmitePriestOptions),
+    optionsFromJson: (obj) => SmitePriestOptions.fromJson(obj),
+    optionsFromPlayer: (player) => player.spec.oneofKind == 'smitePriest'
+				? player.spec.smitePriest.options || SmitePriestOptions.create()
+				: SmitePriestOptions.create(),
+  },
   [Spec.SpecWarlock]: {
     rotationCreate: () => WarlockRotation.create(),
     rotationEquals: (a, b) => WarlockRotation.equals(a as WarlockRotation, b as WarlockRotation),
@@ -617,13 +812,18 @@ export const raceToFaction: Record<Race, Faction> = {
 
 export const specToClass: Record<Spec, Class> = {
   [Spec.SpecBalanceDruid]: Class.ClassDruid,
+  [Spec.SpecRestorationDruid]: Class.ClassDruid,
   [Spec.SpecElementalShaman]: Class.ClassShaman,
   [Spec.SpecEnhancementShaman]: Class.ClassShaman,
+  [Spec.SpecRestorationShaman]: Class.ClassShaman,
   [Spec.SpecHunter]: Class.ClassHunter,
   [Spec.SpecMage]: Class.ClassMage,
   [Spec.SpecRogue]: Class.ClassRogue,
   [Spec.SpecRetributionPaladin]: Class.ClassPaladin,
+  [Spec.SpecHolyPaladin]: Class.ClassPaladin,
   [Spec.SpecShadowPriest]: Class.ClassPriest,
+  [Spec.SpecHolyPriest]: Class.ClassPriest,
+  [Spec.SpecSmitePriest]: Class.ClassPriest,
   [Spec.SpecWarlock]: Class.ClassWarlock,
   [Spec.SpecWarrior]: Class.ClassWarrior,
 };
@@ -709,13 +909,18 @@ const warriorRaces = [
 
 export const specToEligibleRaces: Record<Spec, Array<Race>> = {
   [Spec.SpecBalanceDruid]: druidRaces,
+  [Spec.SpecRestorationDruid]: druidRaces,
   [Spec.SpecElementalShaman]: shamanRaces,
   [Spec.SpecEnhancementShaman]: shamanRaces,
+  [Spec.SpecRestorationShaman]: shamanRaces,
   [Spec.SpecHunter]: hunterRaces,
   [Spec.SpecMage]: mageRaces,
   [Spec.SpecRetributionPaladin]: paladinRaces,
+  [Spec.SpecHolyPaladin]: paladinRaces,
   [Spec.SpecRogue]: rogueRaces,
   [Spec.SpecShadowPriest]: priestRaces,
+  [Spec.SpecHolyPriest]: priestRaces,
+  [Spec.SpecSmitePriest]: priestRaces,
   [Spec.SpecWarlock]: warlockRaces,
   [Spec.SpecWarrior]: warriorRaces,
 };
@@ -733,13 +938,18 @@ const dualWieldSpecs: Array<Spec> = [
 // renamed, DO NOT change these values or people will lose their saved data.
 export const specToLocalStorageKey: Record<Spec, string> = {
   [Spec.SpecBalanceDruid]: '__balance_druid',
+  [Spec.SpecRestorationDruid]: '__restoration_druid',
   [Spec.SpecElementalShaman]: '__elemental_shaman',
   [Spec.SpecEnhancementShaman]: '__enhacement_shaman',
+  [Spec.SpecRestorationShaman]: '__restoration_shaman',
   [Spec.SpecHunter]: '__hunter',
   [Spec.SpecMage]: '__mage',
   [Spec.SpecRetributionPaladin]: '__retribution_paladin',
+  [Spec.SpecHolyPaladin]: '__holy_paladin',
   [Spec.SpecRogue]: '__rogue',
   [Spec.SpecShadowPriest]: '__shadow_priest',
+  [Spec.SpecHolyPriest]: '__holy_priest',
+  [Spec.SpecSmitePriest]: '__smite_priest',
   [Spec.SpecWarlock]: '__warlock',
   [Spec.SpecWarrior]: '__warrior',
 };
@@ -764,6 +974,16 @@ export function withSpecProto<SpecType extends Spec>(
 			}),
 		};
 		return copy;
+	case Spec.SpecRestorationDruid:
+		copy.spec = {
+			oneofKind: 'restorationDruid',
+			restorationDruid: RestorationDruid.create({
+				rotation: rotation as RestorationDruidRotation,
+				talents: talents as DruidTalents,
+				options: specOptions as RestorationDruidOptions,
+			}),
+		};
+		return copy;
 	case Spec.SpecElementalShaman:
 		copy.spec = {
 			oneofKind: 'elementalShaman',
@@ -784,6 +1004,16 @@ export function withSpecProto<SpecType extends Spec>(
 			}),
 		};
 		return copy;
+	case Spec.SpecRestorationShaman:
+		copy.spec = {
+			oneofKind: 'restorationShaman',
+			restorationShaman: RestorationShaman.create({
+				rotation: rotation as RestorationShamanRotation,
+				talents: talents as ShamanTalents,
+				options: specOptions as RestorationShamanOptions,
+			}),
+		};
+		return copy;
 	case Spec.SpecHunter:
 		copy.spec = {
 			oneofKind: 'hunter',
@@ -814,6 +1044,16 @@ export function withSpecProto<SpecType extends Spec>(
 			}),
 		};
 		return copy;
+	case Spec.SpecHolyPaladin:
+		copy.spec = {
+			oneofKind: 'holyPaladin',
+			holyPaladin: HolyPaladin.create({
+				rotation: rotation as HolyPaladinRotation,
+				talents: talents as PaladinTalents,
+				options: specOptions as HolyPaladinOptions,
+			}),
+		};
+		return copy;
 	case Spec.SpecRogue:
 		copy.spec = {
 			oneofKind: 'rogue',
@@ -834,6 +1074,26 @@ export function withSpecProto<SpecType extends Spec>(
 			}),
 		};
 		return copy;
+	case Spec.SpecHolyPriest:
+		copy.spec = {
+			oneofKind: 'holyPriest',
+			holyPriest: HolyPriest.create({
+				rotation: rotation as HolyPriestRotation,
+				talents: talents as PriestTalents,
+				options: specOptions as HolyPriestOptions,
+			}),
+		};
+		return copy;
+	case Spec.SpecSmitePriest:
+		copy.spec = {
+			oneofKind: 'smitePriest',
+			smitePriest: SmitePriest.create({
+				rotation: rotation as SmitePriestRotation,
+				talents: talents as PriestTalents,
+				options: specOptions as SmitePriestOptions,
+			}),
+		};
+		return copy;
 	case Spec.SpecWarlock:
 		copy.spec = {
 			oneofKind: 'warlock',
@@ -1013,12 +1273,18 @@ export const specEPTransforms: Record<Spec, (epWeights: Stats) => Stats> = {
   [Spec.SpecBalanceDruid]: (epWeights: Stats) => {
 		return epWeights.withStat(Stat.StatSpellHit, 0);
 	},
+  [Spec.SpecRestorationDruid]: (epWeights: Stats) => {
+		return epWeights;
+	},
   [Spec.SpecElementalShaman]: (epWeights: Stats) => {
 		return epWeights.withStat(Stat.StatSpellHit, 0);
 	},
   [Spec.SpecEnhancementShaman]: (epWeights: Stats) => {
 		return epWeights;
 	},
+  [Spec.SpecRestorationShaman]: (epWeights: Stats) => {
+		return epWeights;
+	},
   [Spec.SpecHunter]: (epWeights: Stats) => {
 		return epWeights;
 	},
@@ -1031,9 +1297,18 @@ export const specEPTransforms: Record<Spec, (epWeights: Stats) => Stats> = {
   [Spec.SpecRetributionPaladin]: (epWeights: Stats) => {
 		return epWeights.withStat(Stat.StatMeleeHit, 0);
 	},
+  [Spec.SpecHolyPaladin]: (epWeights: Stats) => {
+		return epWeights;
+	},
   [Spec.SpecShadowPriest]: (epWeights: Stats) => {
 		return epWeights.withStat(Stat.StatSpellHit, 0);
 	},
+  [Spec.SpecHolyPriest]: (epWeights: Stats) => {
+		return epWeights;
+	},
+  [Spec.SpecSmitePriest]: (epWeights: Stats) => {
+		return epWeights.withStat(Stat.StatSpellHit, 0);
+	},
   [Spec.SpecWarlock]: (epWeights: Stats) => {
 		return epWeights.withStat(Stat.StatSpellHit, 0);
 	},
@@ -179,6 +179,9 @@ export class Sim {
 		const request = this.makeRaidSimRequest(false);
 		
 		var result = await this.workerPool.raidSimAsync(request, onProgress);
+		if (result.errorResult) {
+			throw new Error(result.errorResult);
+		}
 
 		const simResult = await SimResult.makeNew(request, result);
 		this.simResultEmitter.emit(eventID, simResult);
@@ -196,6 +199,9 @@ export class Sim {
 
 		const request = this.makeRaidSimRequest(true);
 		const result = await this.workerPool.raidSimAsync(request, () => {});
+		if (result.errorResult) {
+			throw new Error(result.errorResult);
+		}
 
 		const simResult = await SimResult.makeNew(request, result);
 		this.simResultEmitter.emit(eventID, simResult);
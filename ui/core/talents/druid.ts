@@ -509,7 +509,7 @@ export class DruidTalentsPicker extends TalentsPicker<Spec.SpecBalanceDruid> {
             maxPoints: 5,
           },
           {
-            //fieldName: 'improvedRejuvenation',
+            fieldName: 'improvedRejuvenation',
             location: {
               rowIdx: 3,
               colIdx: 2,
@@ -531,7 +531,7 @@ export class DruidTalentsPicker extends TalentsPicker<Spec.SpecBalanceDruid> {
             maxPoints: 1,
           },
           {
-            //fieldName: 'giftOfNature',
+            fieldName: 'giftOfNature',
             location: {
               rowIdx: 4,
               colIdx: 1,
@@ -558,7 +558,7 @@ export class DruidTalentsPicker extends TalentsPicker<Spec.SpecBalanceDruid> {
             maxPoints: 2,
           },
           {
-            //fieldName: 'improvedRegrowth',
+            fieldName: 'improvedRegrowth',
             location: {
               rowIdx: 5,
               colIdx: 2,
@@ -602,7 +602,7 @@ export class DruidTalentsPicker extends TalentsPicker<Spec.SpecBalanceDruid> {
             maxPoints: 3,
           },
           {
-            //fieldName: 'empoweredRejuvenation',
+            fieldName: 'empoweredRejuvenation',
             location: {
               rowIdx: 7,
               colIdx: 1,
@@ -611,7 +611,7 @@ export class DruidTalentsPicker extends TalentsPicker<Spec.SpecBalanceDruid> {
             maxPoints: 5,
           },
           {
-            //fieldName: 'treeOfLife',
+            fieldName: 'treeOfLife',
             location: {
               rowIdx: 8,
               colIdx: 1,
@@ -50,7 +50,7 @@ export class PaladinTalentsPicker extends TalentsPicker<Spec.SpecRetributionPala
             maxPoints: 5,
           },
           {
-            //fieldName: 'healingLight',
+            fieldName: 'healingLight',
             location: {
               rowIdx: 2,
               colIdx: 0,
@@ -126,7 +126,7 @@ export class PaladinTalentsPicker extends TalentsPicker<Spec.SpecRetributionPala
             maxPoints: 1,
           },
           {
-            //fieldName: 'sanctifiedLight',
+            fieldName: 'sanctifiedLight',
             location: {
               rowIdx: 4,
               colIdx: 2,
@@ -153,7 +153,7 @@ export class PaladinTalentsPicker extends TalentsPicker<Spec.SpecRetributionPala
             maxPoints: 5,
           },
           {
-            //fieldName: 'lightsGrace',
+            fieldName: 'lightsGrace',
             location: {
               rowIdx: 6,
               colIdx: 0,
@@ -248,7 +248,7 @@ export class PriestTalentsPicker extends TalentsPicker<Spec.SpecShadowPriest> {
             maxPoints: 5,
           },
           {
-            //fieldName: 'improvedRenew',
+            fieldName: 'improvedRenew',
             location: {
               rowIdx: 0,
               colIdx: 1,
@@ -311,7 +311,7 @@ export class PriestTalentsPicker extends TalentsPicker<Spec.SpecShadowPriest> {
             maxPoints: 2,
           },
           {
-            //fieldName: 'improvedHealing',
+            fieldName: 'improvedHealing',
             location: {
               rowIdx: 3,
               colIdx: 1,
@@ -369,7 +369,7 @@ export class PriestTalentsPicker extends TalentsPicker<Spec.SpecShadowPriest> {
             maxPoints: 2,
           },
           {
-            //fieldName: 'spiritualHealing',
+            fieldName: 'spiritualHealing',
             location: {
               rowIdx: 5,
               colIdx: 2,
@@ -409,7 +409,7 @@ export class PriestTalentsPicker extends TalentsPicker<Spec.SpecShadowPriest> {
             maxPoints: 3,
           },
           {
-            //fieldName: 'empoweredHealing',
+            fieldName: 'empoweredHealing',
             location: {
               rowIdx: 7,
               colIdx: 1,
@@ -418,7 +418,7 @@ export class PriestTalentsPicker extends TalentsPicker<Spec.SpecShadowPriest> {
             maxPoints: 5,
           },
           {
-            //fieldName: 'circleOfHealing',
+            fieldName: 'circleOfHealing',
             location: {
               rowIdx: 8,
               colIdx: 1,
@@ -548,7 +548,7 @@ export class ShamanTalentsPicker extends TalentsPicker<Spec.SpecElementalShaman>
             maxPoints: 3,
           },
           {
-            //fieldName: 'purification',
+            fieldName: 'purification',
             location: {
               rowIdx: 5,
               colIdx: 2,
@@ -557,7 +557,7 @@ export class ShamanTalentsPicker extends TalentsPicker<Spec.SpecElementalShaman>
             maxPoints: 5,
           },
           {
-            //fieldName: 'manaTideTotem',
+            fieldName: 'manaTideTotem',
             location: {
               rowIdx: 6,
               colIdx: 1,
@@ -588,7 +588,7 @@ export class ShamanTalentsPicker extends TalentsPicker<Spec.SpecElementalShaman>
             maxPoints: 3,
           },
           {
-            //fieldName: 'improvedChainHeal',
+            fieldName: 'improvedChainHeal',
             location: {
               rowIdx: 7,
               colIdx: 2,
@@ -597,7 +597,7 @@ export class ShamanTalentsPicker extends TalentsPicker<Spec.SpecElementalShaman>
             maxPoints: 2,
           },
           {
-            //fieldName: 'earthShield',
+            fieldName: 'earthShield',
             location: {
               rowIdx: 8,
               colIdx: 1,
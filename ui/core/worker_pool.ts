@@ -3,6 +3,7 @@ import { Enchant } from './proto/common.js';
 import { Gem } from './proto/common.js';
 import { GemColor } from './proto/common.js';
 import { Item } from './proto/common.js';
+import { ItemDatabase } from './proto/common.js';
 import { ItemQuality } from './proto/common.js';
 import { ItemSlot } from './proto/common.js';
 import { ItemSpec } from './proto/common.js';
@@ -10,8 +11,12 @@ import { ItemType } from './proto/common.js';
 import { Stat } from './proto/common.js';
 
 import { ComputeStatsRequest, ComputeStatsResult } from './proto/api.js';
+import { CooldownOptimizerRequest, CooldownOptimizerResult } from './proto/api.js';
+import { EncounterListRequest, EncounterListResult } from './proto/api.js';
 import { GearListRequest, GearListResult } from './proto/api.js';
+import { PartyOptimizerRequest, PartyOptimizerResult } from './proto/api.js';
 import { RaidSimRequest, RaidSimResult, ProgressMetrics} from './proto/api.js';
+import { RotationOptimizerRequest, RotationOptimizerResult } from './proto/api.js';
 import { StatWeightsRequest, StatWeightsResult } from './proto/api.js';
 
 import { wait } from './utils.js';
@@ -42,6 +47,34 @@ export class WorkerPool {
 		return GearListResult.fromBinary(result);
   }
 
+  // Replaces the built-in item data in every worker. Resolves to false if any
+  // worker rejected the database.
+  async loadItemDatabase(db: ItemDatabase): Promise<boolean> {
+    const request = ItemDatabase.toBinary(db);
+    const results = await Promise.all(this.workers.map(worker => worker.doApiCall('loadItemDatabase', request, "")));
+    return results.every(result => result as unknown as boolean);
+  }
+
+  async getEncounterList(request: EncounterListRequest): Promise<EncounterListResult> {
+		const result = await this.makeApiCall('encounterList', EncounterListRequest.toBinary(request));
+		return EncounterListResult.fromBinary(result);
+  }
+
+  async optimizeParties(request: PartyOptimizerRequest): Promise<PartyOptimizerResult> {
+		const result = await this.makeApiCall('partyOptimizer', PartyOptimizerRequest.toBinary(request));
+		return PartyOptimizerResult.fromBinary(result);
+  }
+
+  async optimizeRotation(request: RotationOptimizerRequest): Promise<RotationOptimizerResult> {
+		const result = await this.makeApiCall('rotationOptimizer', RotationOptimizerRequest.toBinary(request));
+		return RotationOptimizerResult.fromBinary(result);
+  }
+
+  async optimizeCooldowns(request: CooldownOptimizerRequest): Promise<CooldownOptimizerResult> {
+		const result = await this.makeApiCall('cooldownOptimizer', CooldownOptimizerRequest.toBinary(request));
+		return CooldownOptimizerResult.fromBinary(result);
+  }
+
   async computeStats(request: ComputeStatsRequest): Promise<ComputeStatsResult> {
 		const result = await this.makeApiCall('computeStats', ComputeStatsRequest.toBinary(request));
 		return ComputeStatsResult.fromBinary(result);
@@ -12,6 +12,7 @@ import { wait } from '/tbc/core/utils.js';
 import { newRaidTarget, emptyRaidTarget, NO_TARGET } from '/tbc/core/proto_utils/utils.js';
 
 import { BalanceDruid_Options as DruidOptions } from '/tbc/core/proto/druid.js';
+import { ShadowPriest_Options as PriestOptions } from '/tbc/core/proto/priest.js';
 
 import { BuffBot } from './buff_bot.js';
 import { RaidSimUI } from './raid_sim_ui.js';
@@ -246,19 +247,20 @@ class PowerInfusionsPicker extends AssignedBuffPicker {
 					if (playerOrBot instanceof BuffBot) {
 						return playerOrBot.settings.buffBotId == 'Divine Spirit Priest';
 					} else {
-						// Only include bots for now, because shadow priest doesn't have a PI field
-						// on its spec proto.
-						return false;
+						// Every priest spec has a PI target in its options.
+						return true;
 					}
 				}) as Array<Player<any> | BuffBot>;
 	}
 
 	getPlayerValue(player: Player<any>): RaidTarget {
-		throw new Error('Unimplemented PowerInfusionsPicker.getPlayerValue');
+		return (player.getSpecOptions() as PriestOptions).powerInfusionTarget || emptyRaidTarget();
 	}
 
 	setPlayerValue(eventID: EventID, player: Player<any>, newValue: RaidTarget) {
-		throw new Error('Unimplemented PowerInfusionsPicker.setPlayerValue');
+		const newOptions = player.getSpecOptions() as PriestOptions;
+		newOptions.powerInfusionTarget = newValue;
+		player.setSpecOptions(eventID, newOptions);
 	}
 
 	getBuffBotValue(buffBot: BuffBot): RaidTarget {
@@ -27,7 +27,11 @@ addEventListener('message', async (e) => {
 
 	[
 		['computeStats', computeStats],
+		['cooldownOptimizer', cooldownOptimizer],
+		['encounterList', encounterList],
 		['gearList', gearList],
+		['loadItemDatabase', loadItemDatabase],
+		['partyOptimizer', partyOptimizer],
 		['raidSim', raidSim],
 		['raidSimAsync', (data) => {
 			return raidSimAsync(data, (result) => {
@@ -38,6 +42,7 @@ addEventListener('message', async (e) => {
 				});
 			});
 		}],
+		['rotationOptimizer', rotationOptimizer],
 		['statWeights', statWeights],
 		['statWeightsAsync', (data) => {
 			return statWeightsAsync(data, (result) => {